@@ -0,0 +1,107 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package external
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ConsensusSigner signs on behalf of a hotstuff validator with the leader and
+// consensus keys held by an external signer (clef), which refuses to sign the
+// messages which would equivocate. It implements core.RemoteSigner.
+type ConsensusSigner struct {
+	client    *rpc.Client
+	address   common.Address
+	publicKey []byte
+}
+
+// NewConsensusSigner connects to the external signer, and retrieves the keys of
+// the validator it signs for.
+func NewConsensusSigner(endpoint string) (*ConsensusSigner, error) {
+	client, err := rpc.Dial(endpoint)
+	if err != nil {
+		return nil, err
+	}
+	signer := &ConsensusSigner{client: client}
+	if err := client.Call(&signer.address, "consensus_address"); err != nil {
+		client.Close()
+		return nil, err
+	}
+	var publicKey hexutil.Bytes
+	if err := client.Call(&publicKey, "consensus_publicKey"); err != nil {
+		client.Close()
+		return nil, err
+	}
+	signer.publicKey = publicKey
+	return signer, nil
+}
+
+// Address returns the account of the leader key.
+func (s *ConsensusSigner) Address() common.Address {
+	return s.address
+}
+
+// PublicKey returns the BLS public key of the consensus key.
+func (s *ConsensusSigner) PublicKey() []byte {
+	return common.CopyBytes(s.publicKey)
+}
+
+// SignSeal signs the leader seal of the header.
+func (s *ConsensusSigner) SignSeal(header *types.Header) ([]byte, error) {
+	var res hexutil.Bytes
+	if err := s.client.Call(&res, "consensus_signSeal", header); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SignMessage signs the rlp encoding of a consensus message without signature.
+func (s *ConsensusSigner) SignMessage(payload []byte) ([]byte, error) {
+	var res hexutil.Bytes
+	if err := s.client.Call(&res, "consensus_signMessage", hexutil.Bytes(payload)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SignVote signs the vote of the given phase of the view with the consensus key.
+func (s *ConsensusSigner) SignVote(code core.MsgType, view *core.View, digest common.Hash) ([]byte, error) {
+	var res hexutil.Bytes
+	if err := s.client.Call(&res, "consensus_signVote", hexutil.Uint64(code), hexutil.Uint64(view.Height.Uint64()), hexutil.Uint64(view.Round.Uint64()), digest); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SignVRF signs the proof of the election of the proposer in the given round
+// with the consensus key.
+func (s *ConsensusSigner) SignVRF(parent common.Hash, round uint64) ([]byte, error) {
+	var res hexutil.Bytes
+	if err := s.client.Call(&res, "consensus_signVRF", parent, hexutil.Uint64(round)); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// Close disconnects from the external signer.
+func (s *ConsensusSigner) Close() {
+	s.client.Close()
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keystore
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/google/uuid"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+)
+
+var (
+	// ErrBLSKeyMismatch is returned if the decrypted BLS key does not match the
+	// public key recorded in the key file.
+	ErrBLSKeyMismatch = errors.New("BLS key does not match its public key")
+
+	// ErrInvalidProofOfPossession is returned if a BLS proof of possession does
+	// not verify against the public key it is presented with.
+	ErrInvalidProofOfPossession = errors.New("invalid BLS proof of possession")
+)
+
+// encryptedBLSKeyJSON is the on-disk encoding of an encrypted BLS consensus key.
+type encryptedBLSKeyJSON struct {
+	PublicKey string     `json:"pubkey"`
+	Crypto    CryptoJSON `json:"crypto"`
+	Id        string     `json:"id"`
+	Version   int        `json:"version"`
+}
+
+// EncryptBLSKey encrypts a BLS consensus key using the specified scrypt parameters
+// into a json blob that can be decrypted later on.
+func EncryptBLSKey(key blscommon.SecretKey, auth string, scryptN, scryptP int) ([]byte, error) {
+	cryptoStruct, err := EncryptDataV3(key.Marshal(), []byte(auth), scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(encryptedBLSKeyJSON{
+		PublicKey: hex.EncodeToString(key.PublicKey().Marshal()),
+		Crypto:    cryptoStruct,
+		Id:        id.String(),
+		Version:   version,
+	})
+}
+
+// DecryptBLSKey decrypts a BLS consensus key from a json blob.
+func DecryptBLSKey(keyjson []byte, auth string) (blscommon.SecretKey, error) {
+	k := new(encryptedBLSKeyJSON)
+	if err := json.Unmarshal(keyjson, k); err != nil {
+		return nil, err
+	}
+	if k.Version != version {
+		return nil, fmt.Errorf("version not supported: %v", k.Version)
+	}
+	keyBytes, err := DecryptDataV3(k.Crypto, auth)
+	if err != nil {
+		return nil, err
+	}
+	key, err := blst.SecretKeyFromBytes(keyBytes)
+	if err != nil {
+		return nil, err
+	}
+	if hex.EncodeToString(key.PublicKey().Marshal()) != k.PublicKey {
+		return nil, ErrBLSKeyMismatch
+	}
+	return key, nil
+}
+
+// StoreBLSKey encrypts the BLS consensus key and atomically writes it to the
+// given file, which is only readable by the current user.
+func StoreBLSKey(file string, key blscommon.SecretKey, auth string, scryptN, scryptP int) error {
+	keyjson, err := EncryptBLSKey(key, auth, scryptN, scryptP)
+	if err != nil {
+		return err
+	}
+	return writeKeyFile(file, keyjson)
+}
+
+// LoadBLSKey reads and decrypts the BLS consensus key stored in the given file.
+func LoadBLSKey(file string, auth string) (blscommon.SecretKey, error) {
+	keyjson, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return DecryptBLSKey(keyjson, auth)
+}
+
+// BLSProofOfPossession signs the public key of the BLS consensus key with the
+// key itself, proving to anyone registering the public key that its owner holds
+// the matching secret and is not mounting a rogue key attack.
+func BLSProofOfPossession(key blscommon.SecretKey) []byte {
+	return key.Sign(key.PublicKey().Marshal()).Marshal()
+}
+
+// VerifyBLSProofOfPossession checks that the proof was created by the owner of
+// the given BLS public key.
+func VerifyBLSProofOfPossession(pubKey []byte, proof []byte) error {
+	pub, err := blst.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return err
+	}
+	sig, err := blst.SignatureFromBytes(proof)
+	if err != nil {
+		return err
+	}
+	if !sig.Verify(pub, pub.Marshal()) {
+		return ErrInvalidProofOfPossession
+	}
+	return nil
+}
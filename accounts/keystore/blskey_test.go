@@ -0,0 +1,67 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keystore
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+)
+
+func TestBLSKeyStoreLoad(t *testing.T) {
+	dir := t.TempDir()
+	file := filepath.Join(dir, "consensuskey")
+
+	key, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := StoreBLSKey(file, key, "foo", veryLightScryptN, veryLightScryptP); err != nil {
+		t.Fatalf("failed to store key: %v", err)
+	}
+	if fi, err := os.Stat(file); err != nil {
+		t.Fatal(err)
+	} else if perm := fi.Mode().Perm(); perm != 0600 {
+		t.Errorf("key file has permissions %o, want 0600", perm)
+	}
+	loaded, err := LoadBLSKey(file, "foo")
+	if err != nil {
+		t.Fatalf("failed to load key: %v", err)
+	}
+	if !bytes.Equal(loaded.Marshal(), key.Marshal()) {
+		t.Fatalf("loaded key mismatch: have %x, want %x", loaded.Marshal(), key.Marshal())
+	}
+	if _, err := LoadBLSKey(file, "bar"); err != ErrDecrypt {
+		t.Fatalf("wrong password error mismatch: have %v, want %v", err, ErrDecrypt)
+	}
+}
+
+func TestBLSProofOfPossession(t *testing.T) {
+	key, _ := blst.RandKey()
+	other, _ := blst.RandKey()
+
+	proof := BLSProofOfPossession(key)
+	if err := VerifyBLSProofOfPossession(key.PublicKey().Marshal(), proof); err != nil {
+		t.Fatalf("valid proof rejected: %v", err)
+	}
+	if err := VerifyBLSProofOfPossession(other.PublicKey().Marshal(), proof); err != ErrInvalidProofOfPossession {
+		t.Fatalf("foreign proof error mismatch: have %v, want %v", err, ErrInvalidProofOfPossession)
+	}
+}
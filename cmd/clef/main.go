@@ -135,6 +135,14 @@ var (
 		Name:  "stdio-ui-test",
 		Usage: "Mechanism to test interface between Clef and UI. Requires 'stdio-ui'.",
 	}
+	consensusAccountFlag = cli.StringFlag{
+		Name:  "consensus.account",
+		Usage: "Hotstuff validator account to sign leader seals and consensus messages with, over IPC only",
+	}
+	consensusKeyFlag = cli.StringFlag{
+		Name:  "consensus.key",
+		Usage: "BLS key file of the hotstuff validator to sign votes with, encrypted with the password of the validator account",
+	}
 	app         = cli.NewApp()
 	initCommand = cli.Command{
 		Action:    utils.MigrateFlags(initializeSecrets),
@@ -241,6 +249,8 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			ruleFlag,
 			stdiouiFlag,
 			testFlag,
+			consensusAccountFlag,
+			consensusKeyFlag,
 			advancedMode,
 			acceptFlag,
 		},
@@ -270,6 +280,8 @@ func init() {
 		ruleFlag,
 		stdiouiFlag,
 		testFlag,
+		consensusAccountFlag,
+		consensusKeyFlag,
 		advancedMode,
 		acceptFlag,
 	}
@@ -652,6 +664,21 @@ func signer(c *cli.Context) error {
 			Service:   api,
 			Version:   "1.0"},
 	}
+	// The consensus API is only registered on IPC, the http endpoint exposes the
+	// account namespace only.
+	if c.GlobalIsSet(consensusAccountFlag.Name) {
+		consensusAPI, err := newConsensusAPI(c, am, ui, pwStorage, configDir)
+		if err != nil {
+			utils.Fatalf("Could not start consensus api: %v", err)
+		}
+		log.Info("Consensus signing enabled", "validator", consensusAPI.Address())
+		rpcAPI = append(rpcAPI, rpc.API{
+			Namespace: "consensus",
+			Public:    true,
+			Service:   consensusAPI,
+			Version:   "1.0",
+		})
+	}
 	if c.GlobalBool(utils.HTTPEnabledFlag.Name) {
 		vhosts := utils.SplitAndTrim(c.GlobalString(utils.HTTPVirtualHostsFlag.Name))
 		cors := utils.SplitAndTrim(c.GlobalString(utils.HTTPCORSDomainFlag.Name))
@@ -717,6 +744,53 @@ func signer(c *cli.Context) error {
 	return nil
 }
 
+// newConsensusAPI unlocks the validator account and BLS key given on the command
+// line, and opens the slashing protection database in the config directory. The
+// password of the account is taken from the credential store if present.
+func newConsensusAPI(c *cli.Context, am *accounts.Manager, ui core.UIClientAPI, pwStorage storage.Storage, configDir string) (*core.ConsensusAPI, error) {
+	if !common.IsHexAddress(c.GlobalString(consensusAccountFlag.Name)) {
+		return nil, fmt.Errorf("invalid validator account %q", c.GlobalString(consensusAccountFlag.Name))
+	}
+	if !c.GlobalIsSet(consensusKeyFlag.Name) {
+		return nil, fmt.Errorf("missing BLS key file, set --%s", consensusKeyFlag.Name)
+	}
+	backends := am.Backends(keystore.KeyStoreType)
+	if len(backends) == 0 {
+		return nil, fmt.Errorf("keystore is not available")
+	}
+	ks := backends[0].(*keystore.KeyStore)
+	account, err := ks.Find(accounts.Account{Address: common.HexToAddress(c.GlobalString(consensusAccountFlag.Name))})
+	if err != nil {
+		return nil, err
+	}
+	password, err := pwStorage.Get(account.Address.Hex())
+	if err != nil {
+		resp, err := ui.OnInputRequired(core.UserInputRequest{
+			Title:      "Validator Password",
+			Prompt:     fmt.Sprintf("Please enter the password of validator %v", account.Address),
+			IsPassword: true})
+		if err != nil {
+			return nil, err
+		}
+		password = resp.Text
+	}
+	if err := ks.Unlock(account, password); err != nil {
+		return nil, err
+	}
+	key, err := keystore.LoadBLSKey(c.GlobalString(consensusKeyFlag.Name), password)
+	if err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(configDir, 0700); err != nil {
+		return nil, err
+	}
+	protection, err := core.NewSlashingProtection(filepath.Join(configDir, "slashing.json"))
+	if err != nil {
+		return nil, err
+	}
+	return core.NewConsensusAPI(ks, account, key, protection), nil
+}
+
 // DefaultConfigDir is the default config directory to use for the vaults and other
 // persistence requirements.
 func DefaultConfigDir() string {
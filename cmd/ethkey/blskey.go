@@ -0,0 +1,213 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/cmd/utils"
+	common2 "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/staking"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"gopkg.in/urfave/cli.v1"
+)
+
+const (
+	defaultBLSKeyfileName = "consensuskey"
+)
+
+var addressFlag = cli.StringFlag{
+	Name:  "address",
+	Usage: "validator account to bind the proof of possession to, as hotstuff_propose and the staking contract require",
+}
+
+type outputBLSKey struct {
+	PublicKey         string
+	ProofOfPossession string
+	PrivateKey        string `json:",omitempty"`
+}
+
+var commandBLSKey = cli.Command{
+	Name:  "blskey",
+	Usage: "manage BLS consensus keyfiles",
+	Description: `
+Manage the BLS keyfiles hotstuff validators sign consensus messages with. The
+keyfiles are loaded by geth through the --consensuskey flag, or from the
+consensuskey file of the data directory.`,
+	Subcommands: []cli.Command{
+		commandBLSKeyGenerate,
+		commandBLSKeyInspect,
+	},
+}
+
+var commandBLSKeyGenerate = cli.Command{
+	Name:      "generate",
+	Usage:     "generate new BLS keyfile",
+	ArgsUsage: "[ <keyfile> ]",
+	Description: `
+Generate a new BLS consensus keyfile, and print its public key along with the
+proof of possession needed to register it.
+
+If you want to encrypt an existing BLS key, it can be specified by setting
+--privatekey with the location of the file containing the hex encoded key.
+`,
+	Flags: []cli.Flag{
+		passphraseFlag,
+		jsonFlag,
+		addressFlag,
+		cli.StringFlag{
+			Name:  "privatekey",
+			Usage: "file containing a hex encoded BLS key to encrypt",
+		},
+		cli.BoolFlag{
+			Name:  "lightkdf",
+			Usage: "use less secure scrypt parameters",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		// Check if keyfile path given and make sure it doesn't already exist.
+		keyfilepath := ctx.Args().First()
+		if keyfilepath == "" {
+			keyfilepath = defaultBLSKeyfileName
+		}
+		if _, err := os.Stat(keyfilepath); err == nil {
+			utils.Fatalf("Keyfile already exists at %s.", keyfilepath)
+		} else if !os.IsNotExist(err) {
+			utils.Fatalf("Error checking if keyfile exists: %v", err)
+		}
+
+		var key common.SecretKey
+		if file := ctx.String("privatekey"); file != "" {
+			// Load BLS key from file.
+			content, err := ioutil.ReadFile(file)
+			if err != nil {
+				utils.Fatalf("Can't read BLS key: %v", err)
+			}
+			raw, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(string(content)), "0x"))
+			if err != nil {
+				utils.Fatalf("Can't decode BLS key: %v", err)
+			}
+			if key, err = blst.SecretKeyFromBytes(raw); err != nil {
+				utils.Fatalf("Can't load BLS key: %v", err)
+			}
+		} else {
+			// If not loaded, generate random.
+			var err error
+			if key, err = blst.RandKey(); err != nil {
+				utils.Fatalf("Failed to generate random BLS key: %v", err)
+			}
+		}
+
+		// Encrypt key with passphrase.
+		passphrase := getPassphrase(ctx, true)
+		scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+		if ctx.Bool("lightkdf") {
+			scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+		}
+		keyjson, err := keystore.EncryptBLSKey(key, passphrase, scryptN, scryptP)
+		if err != nil {
+			utils.Fatalf("Error encrypting key: %v", err)
+		}
+
+		// Store the file to disk.
+		if err := os.MkdirAll(filepath.Dir(keyfilepath), 0700); err != nil {
+			utils.Fatalf("Could not create directory %s", filepath.Dir(keyfilepath))
+		}
+		if err := ioutil.WriteFile(keyfilepath, keyjson, 0600); err != nil {
+			utils.Fatalf("Failed to write keyfile to %s: %v", keyfilepath, err)
+		}
+		printBLSKey(ctx, key, false)
+		return nil
+	},
+}
+
+var commandBLSKeyInspect = cli.Command{
+	Name:      "inspect",
+	Usage:     "inspect a BLS keyfile",
+	ArgsUsage: "<keyfile>",
+	Description: `
+Print the public key of the BLS keyfile, along with its proof of possession.
+Set --address to the validator account to get the proof needed to register the
+key with hotstuff_propose or the staking contract.
+
+Private key information can be printed by using the --private flag;
+make sure to use this feature with great caution!`,
+	Flags: []cli.Flag{
+		passphraseFlag,
+		jsonFlag,
+		addressFlag,
+		cli.BoolFlag{
+			Name:  "private",
+			Usage: "include the private key in the output",
+		},
+	},
+	Action: func(ctx *cli.Context) error {
+		keyfilepath := ctx.Args().First()
+
+		// Read key from file.
+		keyjson, err := ioutil.ReadFile(keyfilepath)
+		if err != nil {
+			utils.Fatalf("Failed to read the keyfile at '%s': %v", keyfilepath, err)
+		}
+
+		// Decrypt key with passphrase.
+		passphrase := getPassphrase(ctx, false)
+		key, err := keystore.DecryptBLSKey(keyjson, passphrase)
+		if err != nil {
+			utils.Fatalf("Error decrypting key: %v", err)
+		}
+		printBLSKey(ctx, key, ctx.Bool("private"))
+		return nil
+	},
+}
+
+// printBLSKey outputs the public information of the BLS key, and optionally
+// the key itself. The proof of possession is bound to the account given with
+// --address, if any.
+func printBLSKey(ctx *cli.Context, key common.SecretKey, showPrivate bool) {
+	proof := keystore.BLSProofOfPossession(key)
+	if ctx.IsSet(addressFlag.Name) {
+		addr := ctx.String(addressFlag.Name)
+		if !common2.IsHexAddress(addr) {
+			utils.Fatalf("Invalid address: %s", addr)
+		}
+		proof = staking.ProofOfPossession(key, common2.HexToAddress(addr))
+	}
+	out := outputBLSKey{
+		PublicKey:         hex.EncodeToString(key.PublicKey().Marshal()),
+		ProofOfPossession: hex.EncodeToString(proof),
+	}
+	if showPrivate {
+		out.PrivateKey = hex.EncodeToString(key.Marshal())
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		mustPrintJSON(out)
+	} else {
+		fmt.Println("Public key:          ", out.PublicKey)
+		fmt.Println("Proof of possession: ", out.ProofOfPossession)
+		if showPrivate {
+			fmt.Println("Private key:         ", out.PrivateKey)
+		}
+	}
+}
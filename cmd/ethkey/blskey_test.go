@@ -0,0 +1,78 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of go-ethereum.
+//
+// go-ethereum is free software: you can redistribute it and/or modify
+// it under the terms of the GNU General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// go-ethereum is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU General Public License for more details.
+//
+// You should have received a copy of the GNU General Public License
+// along with go-ethereum. If not, see <http://www.gnu.org/licenses/>.
+
+package main
+
+import (
+	"encoding/hex"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/staking"
+)
+
+func TestBLSKeyGenerateInspect(t *testing.T) {
+	keyfile := filepath.Join(t.TempDir(), "consensuskey")
+
+	// Create the key.
+	generate := runEthkey(t, "blskey", "generate", "--lightkdf", keyfile)
+	generate.Expect(`
+!! Unsupported terminal, password will be echoed.
+Password: {{.InputLine "foobar"}}
+Repeat password: {{.InputLine "foobar"}}
+`)
+	_, matches := generate.ExpectRegexp(`Public key: +([0-9a-f]{96})\nProof of possession: +([0-9a-f]{192})\n`)
+	pubKey, proof := matches[1], matches[2]
+	generate.ExpectExit()
+
+	// Inspect it and check the proof of possession.
+	inspect := runEthkey(t, "blskey", "inspect", keyfile)
+	inspect.Expect(`
+!! Unsupported terminal, password will be echoed.
+Password: {{.InputLine "foobar"}}
+`)
+	_, matches = inspect.ExpectRegexp(`Public key: +([0-9a-f]{96})\nProof of possession: +[0-9a-f]{192}\n`)
+	inspect.ExpectExit()
+
+	if matches[1] != pubKey {
+		t.Errorf("inspected public key mismatch: have %s, want %s", matches[1], pubKey)
+	}
+	rawPubKey, _ := hex.DecodeString(pubKey)
+	rawProof, _ := hex.DecodeString(proof)
+	if err := keystore.VerifyBLSProofOfPossession(rawPubKey, rawProof); err != nil {
+		t.Errorf("invalid proof of possession: %v", err)
+	}
+
+	// Inspect it again to get the proof binding it to a validator account.
+	addr := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	inspect = runEthkey(t, "blskey", "inspect", "--address", addr.Hex(), keyfile)
+	inspect.Expect(`
+!! Unsupported terminal, password will be echoed.
+Password: {{.InputLine "foobar"}}
+`)
+	_, matches = inspect.ExpectRegexp(`Public key: +[0-9a-f]{96}\nProof of possession: +([0-9a-f]{192})\n`)
+	inspect.ExpectExit()
+
+	rawProof, _ = hex.DecodeString(matches[1])
+	if !staking.VerifyProofOfPossession(addr, rawPubKey, rawProof) {
+		t.Errorf("invalid proof of possession of %v", addr)
+	}
+	if staking.VerifyProofOfPossession(common.Address{}, rawPubKey, rawProof) {
+		t.Errorf("proof of possession of %v valid for another account", addr)
+	}
+}
@@ -42,6 +42,7 @@ func init() {
 		commandChangePassphrase,
 		commandSignMessage,
 		commandVerifyMessage,
+		commandBLSKey,
 	}
 	cli.CommandHelpTemplate = flags.OriginCommandHelpTemplate
 }
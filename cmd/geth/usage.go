@@ -75,6 +75,7 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 		Flags: []cli.Flag{
 			utils.DeveloperFlag,
 			utils.DeveloperPeriodFlag,
+			utils.DeveloperHotstuffFlag,
 		},
 	},
 	{
@@ -173,6 +174,9 @@ var AppHelpFlagGroups = []flags.FlagGroup{
 			utils.NetrestrictFlag,
 			utils.NodeKeyFileFlag,
 			utils.NodeKeyHexFlag,
+			utils.ConsensusKeyFileFlag,
+			utils.ConsensusKeyHexFlag,
+			utils.ConsensusSignerFlag,
 		},
 	},
 	{
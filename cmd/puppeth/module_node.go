@@ -27,6 +27,7 @@ import (
 	"text/template"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 )
 
@@ -39,10 +40,15 @@ ADD genesis.json /genesis.json
 	ADD signer.json /signer.json
 	ADD signer.pass /signer.pass
 {{end}}
+{{if .Validator}}
+	ADD nodekey /nodekey
+	ADD consensus.json /consensus.json
+	ADD consensus.pass /consensus.pass
+{{end}}
 RUN \
   echo 'geth --cache 512 init /genesis.json' > geth.sh && \{{if .Unlock}}
 	echo 'mkdir -p /root/.ethereum/keystore/ && cp /signer.json /root/.ethereum/keystore/' >> geth.sh && \{{end}}
-	echo $'exec geth --networkid {{.NetworkID}} --cache 512 --port {{.Port}} --nat extip:{{.IP}} --maxpeers {{.Peers}} {{.LightFlag}} --ethstats \'{{.Ethstats}}\' {{if .Bootnodes}}--bootnodes {{.Bootnodes}}{{end}} {{if .Etherbase}}--miner.etherbase {{.Etherbase}} --mine --miner.threads 1{{end}} {{if .Unlock}}--unlock 0 --password /signer.pass --mine{{end}} --miner.gastarget {{.GasTarget}} --miner.gaslimit {{.GasLimit}} --miner.gasprice {{.GasPrice}}' >> geth.sh
+	echo $'exec geth --networkid {{.NetworkID}} --cache 512 --port {{.Port}} --nat extip:{{.IP}} --maxpeers {{.Peers}} {{.LightFlag}} --ethstats \'{{.Ethstats}}\' {{if .Bootnodes}}--bootnodes {{.Bootnodes}}{{end}} {{if .Etherbase}}--miner.etherbase {{.Etherbase}} --mine --miner.threads 1{{end}} {{if .Unlock}}--unlock 0 --password /signer.pass --mine{{end}} {{if .Validator}}--nodekey /nodekey --consensuskey /consensus.json --password /consensus.pass --miner.etherbase {{.Validator}} --mine{{end}} --miner.gastarget {{.GasTarget}} --miner.gaslimit {{.GasLimit}} --miner.gasprice {{.GasPrice}}' >> geth.sh
 
 ENTRYPOINT ["/bin/sh", "geth.sh"]
 `
@@ -84,7 +90,7 @@ services:
 // already exists there, it will be overwritten!
 func deployNode(client *sshClient, network string, bootnodes []string, config *nodeInfos, nocache bool) ([]byte, error) {
 	kind := "sealnode"
-	if config.keyJSON == "" && config.etherbase == "" {
+	if config.keyJSON == "" && config.etherbase == "" && config.nodeKey == "" {
 		kind = "bootnode"
 		bootnodes = make([]string, 0)
 	}
@@ -92,6 +98,10 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 	workdir := fmt.Sprintf("%d", rand.Int63())
 	files := make(map[string][]byte)
 
+	validator := ""
+	if key, err := crypto.HexToECDSA(config.nodeKey); err == nil {
+		validator = crypto.PubkeyToAddress(key.PublicKey).Hex()
+	}
 	lightFlag := ""
 	if config.peersLight > 0 {
 		lightFlag = fmt.Sprintf("--light.maxpeers=%d --light.serve=50", config.peersLight)
@@ -110,6 +120,7 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		"GasLimit":  uint64(1000000 * config.gasLimit),
 		"GasPrice":  uint64(1000000000 * config.gasPrice),
 		"Unlock":    config.keyJSON != "",
+		"Validator": validator,
 	})
 	files[filepath.Join(workdir, "Dockerfile")] = dockerfile.Bytes()
 
@@ -136,6 +147,11 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 		files[filepath.Join(workdir, "signer.json")] = []byte(config.keyJSON)
 		files[filepath.Join(workdir, "signer.pass")] = []byte(config.keyPass)
 	}
+	if config.nodeKey != "" {
+		files[filepath.Join(workdir, "nodekey")] = []byte(config.nodeKey)
+		files[filepath.Join(workdir, "consensus.json")] = []byte(config.consensusJSON)
+		files[filepath.Join(workdir, "consensus.pass")] = []byte(config.consensusPass)
+	}
 	// Upload the deployment files to the remote server (and clean up afterwards)
 	if out, err := client.Upload(files); err != nil {
 		return out, err
@@ -152,21 +168,24 @@ func deployNode(client *sshClient, network string, bootnodes []string, config *n
 // nodeInfos is returned from a boot or seal node status check to allow reporting
 // various configuration parameters.
 type nodeInfos struct {
-	genesis    []byte
-	network    int64
-	datadir    string
-	ethashdir  string
-	ethstats   string
-	port       int
-	enode      string
-	peersTotal int
-	peersLight int
-	etherbase  string
-	keyJSON    string
-	keyPass    string
-	gasTarget  float64
-	gasLimit   float64
-	gasPrice   float64
+	genesis       []byte
+	network       int64
+	datadir       string
+	ethashdir     string
+	ethstats      string
+	port          int
+	enode         string
+	peersTotal    int
+	peersLight    int
+	etherbase     string
+	keyJSON       string
+	keyPass       string
+	nodeKey       string
+	consensusJSON string
+	consensusPass string
+	gasTarget     float64
+	gasLimit      float64
+	gasPrice      float64
 }
 
 // Report converts the typed struct into a plain string->string map, containing
@@ -201,6 +220,22 @@ func (info *nodeInfos) Report() map[string]string {
 				log.Error("Failed to retrieve signer address", "err", err)
 			}
 		}
+		if info.nodeKey != "" {
+			// HotStuff byzantine fault tolerant validator
+			if key, err := crypto.HexToECDSA(info.nodeKey); err == nil {
+				report["Validator account"] = crypto.PubkeyToAddress(key.PublicKey).Hex()
+			} else {
+				log.Error("Failed to retrieve validator address", "err", err)
+			}
+			var key struct {
+				PublicKey string `json:"pubkey"`
+			}
+			if err := json.Unmarshal([]byte(info.consensusJSON), &key); err == nil {
+				report["Consensus public key"] = "0x" + key.PublicKey
+			} else {
+				log.Error("Failed to retrieve consensus public key", "err", err)
+			}
+		}
 	}
 	return report
 }
@@ -246,6 +281,16 @@ func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error)
 	if out, err = client.Run(fmt.Sprintf("docker exec %s_%s_1 cat /signer.pass", network, kind)); err == nil {
 		keyPass = string(bytes.TrimSpace(out))
 	}
+	nodeKey, consensusJSON, consensusPass := "", "", ""
+	if out, err = client.Run(fmt.Sprintf("docker exec %s_%s_1 cat /nodekey", network, kind)); err == nil {
+		nodeKey = string(bytes.TrimSpace(out))
+	}
+	if out, err = client.Run(fmt.Sprintf("docker exec %s_%s_1 cat /consensus.json", network, kind)); err == nil {
+		consensusJSON = string(bytes.TrimSpace(out))
+	}
+	if out, err = client.Run(fmt.Sprintf("docker exec %s_%s_1 cat /consensus.pass", network, kind)); err == nil {
+		consensusPass = string(bytes.TrimSpace(out))
+	}
 	// Run a sanity check to see if the devp2p is reachable
 	port := infos.portmap[infos.envvars["PORT"]]
 	if err = checkPort(client.server, port); err != nil {
@@ -253,19 +298,22 @@ func checkNode(client *sshClient, network string, boot bool) (*nodeInfos, error)
 	}
 	// Assemble and return the useful infos
 	stats := &nodeInfos{
-		genesis:    genesis,
-		datadir:    infos.volumes["/root/.ethereum"],
-		ethashdir:  infos.volumes["/root/.ethash"],
-		port:       port,
-		peersTotal: totalPeers,
-		peersLight: lightPeers,
-		ethstats:   infos.envvars["STATS_NAME"],
-		etherbase:  infos.envvars["MINER_NAME"],
-		keyJSON:    keyJSON,
-		keyPass:    keyPass,
-		gasTarget:  gasTarget,
-		gasLimit:   gasLimit,
-		gasPrice:   gasPrice,
+		genesis:       genesis,
+		datadir:       infos.volumes["/root/.ethereum"],
+		ethashdir:     infos.volumes["/root/.ethash"],
+		port:          port,
+		peersTotal:    totalPeers,
+		peersLight:    lightPeers,
+		ethstats:      infos.envvars["STATS_NAME"],
+		etherbase:     infos.envvars["MINER_NAME"],
+		keyJSON:       keyJSON,
+		keyPass:       keyPass,
+		nodeKey:       nodeKey,
+		consensusJSON: consensusJSON,
+		consensusPass: consensusPass,
+		gasTarget:     gasTarget,
+		gasLimit:      gasLimit,
+		gasPrice:      gasPrice,
 	}
 	stats.enode = string(enode)
 
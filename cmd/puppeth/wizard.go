@@ -17,6 +17,7 @@
 package main
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
@@ -35,6 +36,7 @@ import (
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/peterh/liner"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
 	"golang.org/x/crypto/ssh/terminal"
 )
 
@@ -276,6 +278,24 @@ func (w *wizard) readDefaultAddress(def common.Address) common.Address {
 	}
 }
 
+// readBLSPublicKey reads a single line from stdin, trimming if from spaces and
+// converts it to a BLS public key, retrying until a valid one is entered.
+func (w *wizard) readBLSPublicKey() []byte {
+	for {
+		text := promptInput("> 0x")
+		key, err := hex.DecodeString(strings.TrimSpace(text))
+		if err != nil {
+			log.Error("Invalid public key hex, please retry", "err", err)
+			continue
+		}
+		if _, err := blst.PublicKeyFromBytes(key); err != nil {
+			log.Error("Invalid BLS public key, please retry", "err", err)
+			continue
+		}
+		return key
+	}
+}
+
 // readJSON reads a raw JSON message and returns it.
 func (w *wizard) readJSON() string {
 	var blob json.RawMessage
@@ -27,10 +27,13 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
 	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -59,6 +62,7 @@ func (w *wizard) makeGenesis() {
 	fmt.Println("Which consensus engine to use? (default = clique)")
 	fmt.Println(" 1. Ethash - proof-of-work")
 	fmt.Println(" 2. Clique - proof-of-authority")
+	fmt.Println(" 3. HotStuff - byzantine fault tolerant proof-of-authority")
 
 	choice := w.read()
 	switch {
@@ -105,6 +109,69 @@ func (w *wizard) makeGenesis() {
 			copy(genesis.ExtraData[32+i*common.AddressLength:], signer[:])
 		}
 
+	case choice == "3":
+		// In the case of hotstuff, configure the consensus parameters
+		genesis.Difficulty = big.NewInt(1)
+		genesis.Mixhash = types.HotstuffDigest
+		genesis.Config.HotStuff = &params.HotStuffConfig{
+			Period: 3,
+			Epoch:  30000,
+		}
+		fmt.Println()
+		fmt.Println("How many seconds should blocks take? (default = 3)")
+		genesis.Config.HotStuff.Period = uint64(w.readDefaultInt(3))
+
+		fmt.Println()
+		fmt.Println("How many blocks should an epoch last? (default = 30000)")
+		genesis.Config.HotStuff.Epoch = uint64(w.readDefaultInt(30000))
+
+		fmt.Println()
+		fmt.Println("Which policy should elect the proposer? (default = round robin)")
+		fmt.Println(" 1. Round robin - rotate the proposer every block")
+		fmt.Println(" 2. Sticky - keep the proposer until its round fails")
+		fmt.Println(" 3. VRF - draw the proposer with a verifiable random function")
+
+		switch policy := w.read(); policy {
+		case "", "1":
+			genesis.Config.HotStuff.ElectPolicy = uint64(interfaces.RoundRobin)
+		case "2":
+			genesis.Config.HotStuff.ElectPolicy = uint64(interfaces.Sticky)
+		case "3":
+			genesis.Config.HotStuff.ElectPolicy = uint64(interfaces.VRF)
+		default:
+			log.Crit("Invalid proposer policy choice", "choice", policy)
+		}
+		// We also need the initial list of validators and their consensus keys
+		fmt.Println()
+		fmt.Println("Which accounts are allowed to validate? (mandatory at least one)")
+
+		var validators []params.HotStuffValidator
+		for {
+			if address := w.readAddress(); address != nil {
+				fmt.Printf("What's the BLS public key of %s?\n", address.Hex())
+				validators = append(validators, params.HotStuffValidator{
+					Address:   *address,
+					PublicKey: w.readBLSPublicKey(),
+				})
+				fmt.Println("Which other accounts are allowed to validate? (empty to stop)")
+				continue
+			}
+			if len(validators) > 0 {
+				break
+			}
+		}
+		// Sort the validators and embed into the extra-data section
+		sort.Slice(validators, func(i, j int) bool {
+			return bytes.Compare(validators[i].Address[:], validators[j].Address[:]) < 0
+		})
+		genesis.Config.HotStuff.Validators = validators
+
+		header := &types.Header{Extra: make([]byte, types.HotstuffExtraVanity)}
+		if err := types.HotstuffHeaderFillWithValidators(header, genesis.Config.HotStuff.ValidatorAddresses()); err != nil {
+			log.Crit("Failed to encode validator extra-data", "err", err)
+		}
+		genesis.ExtraData = header.Extra
+
 	default:
 		log.Crit("Invalid consensus engine choice", "choice", choice)
 	}
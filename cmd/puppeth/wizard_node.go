@@ -17,13 +17,19 @@
 package main
 
 import (
+	"bytes"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
 )
 
 // deployNode creates a new node configuration based on some user input.
@@ -146,6 +152,101 @@ func (w *wizard) deployNode(boot bool) {
 					return
 				}
 			}
+		} else if w.conf.Genesis.Config.HotStuff != nil {
+			// If a previous validator was already set, offer to reuse it
+			if infos.nodeKey != "" {
+				if key, err := crypto.HexToECDSA(infos.nodeKey); err != nil {
+					infos.nodeKey = ""
+				} else {
+					fmt.Println()
+					fmt.Printf("Reuse previous (%s) validator node key (y/n)? (default = yes)\n", crypto.PubkeyToAddress(key.PublicKey).Hex())
+					if !w.readDefaultYesNo(true) {
+						infos.nodeKey = ""
+					}
+				}
+			}
+			// Hotstuff validators are identified by their node key, ask or generate if unavailable
+			if infos.nodeKey == "" {
+				fmt.Println()
+				fmt.Println("Please paste the validator's node key hex (empty = generate a new one):")
+				if infos.nodeKey = strings.TrimPrefix(w.readDefaultString(""), "0x"); infos.nodeKey == "" {
+					key, err := crypto.GenerateKey()
+					if err != nil {
+						log.Error("Failed to generate node key", "err", err)
+						return
+					}
+					infos.nodeKey = hex.EncodeToString(crypto.FromECDSA(key))
+				}
+				if _, err := crypto.HexToECDSA(infos.nodeKey); err != nil {
+					log.Error("Invalid node key", "err", err)
+					return
+				}
+			}
+			// If a previous consensus key was already set, offer to reuse it
+			if infos.consensusJSON != "" {
+				if key, err := keystore.DecryptBLSKey([]byte(infos.consensusJSON), infos.consensusPass); err != nil {
+					infos.consensusJSON, infos.consensusPass = "", ""
+				} else {
+					fmt.Println()
+					fmt.Printf("Reuse previous (0x%x) consensus key (y/n)? (default = yes)\n", key.PublicKey().Marshal())
+					if !w.readDefaultYesNo(true) {
+						infos.consensusJSON, infos.consensusPass = "", ""
+					}
+				}
+			}
+			// Votes are signed with a BLS consensus key and unlock password, ask or generate if unavailable
+			if infos.consensusJSON == "" {
+				fmt.Println()
+				fmt.Println("Generate a new BLS consensus key (y/n)? (default = no)")
+				if w.readDefaultYesNo(false) {
+					fmt.Println()
+					fmt.Println("What password should encrypt the consensus key? (won't be echoed)")
+					infos.consensusPass = w.readPassword()
+
+					key, err := blst.RandKey()
+					if err != nil {
+						log.Error("Failed to generate consensus key", "err", err)
+						return
+					}
+					keyJSON, err := keystore.EncryptBLSKey(key, infos.consensusPass, keystore.StandardScryptN, keystore.StandardScryptP)
+					if err != nil {
+						log.Error("Failed to encrypt consensus key", "err", err)
+						return
+					}
+					infos.consensusJSON = string(keyJSON)
+				} else {
+					fmt.Println()
+					fmt.Println("Please paste the validator's consensus key JSON:")
+					infos.consensusJSON = w.readJSON()
+
+					fmt.Println()
+					fmt.Println("What's the unlock password for the consensus key? (won't be echoed)")
+					infos.consensusPass = w.readPassword()
+				}
+			}
+			consensusKey, err := keystore.DecryptBLSKey([]byte(infos.consensusJSON), infos.consensusPass)
+			if err != nil {
+				log.Error("Failed to decrypt consensus key with given password", "err", err)
+				return
+			}
+			// Make sure the keys match the genesis validator, or tell the user what to vote in
+			nodeKey, _ := crypto.HexToECDSA(infos.nodeKey)
+			address, pubkey := crypto.PubkeyToAddress(nodeKey.PublicKey), consensusKey.PublicKey().Marshal()
+
+			genesisValidator := false
+			for _, val := range w.conf.Genesis.Config.HotStuff.Validators {
+				if val.Address != address {
+					continue
+				}
+				if !bytes.Equal(val.PublicKey, pubkey) {
+					log.Error("Consensus key does not match the genesis validator", "address", address, "have", hexutil.Bytes(pubkey), "want", val.PublicKey)
+					return
+				}
+				genesisValidator = true
+			}
+			if !genesisValidator {
+				log.Warn("Validator not in the genesis set, it needs to be voted in", "address", address, "pubkey", hexutil.Bytes(pubkey))
+			}
 		}
 		// Establish the gas dynamics to be enforced by the signer
 		fmt.Println()
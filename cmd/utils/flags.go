@@ -67,6 +67,8 @@ import (
 	"github.com/ethereum/go-ethereum/p2p/netutil"
 	"github.com/ethereum/go-ethereum/params"
 	pcsclite "github.com/gballet/go-libpcsclite"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
 	gopsutil "github.com/shirou/gopsutil/mem"
 	"gopkg.in/urfave/cli.v1"
 )
@@ -167,6 +169,10 @@ var (
 		Name:  "dev.period",
 		Usage: "Block period to use in developer mode (0 = mine only if transaction pending)",
 	}
+	DeveloperHotstuffFlag = cli.BoolFlag{
+		Name:  "dev.hotstuff",
+		Usage: "Seal the developer network with a single hotstuff validator instead of clique",
+	}
 	IdentityFlag = cli.StringFlag{
 		Name:  "identity",
 		Usage: "Custom node name",
@@ -648,6 +654,18 @@ var (
 		Name:  "nodekeyhex",
 		Usage: "P2P node key as hex (for testing)",
 	}
+	ConsensusKeyFileFlag = cli.StringFlag{
+		Name:  "consensuskey",
+		Usage: "Encrypted BLS consensus key file (unlocked with the first --password line)",
+	}
+	ConsensusKeyHexFlag = cli.StringFlag{
+		Name:  "consensuskeyhex",
+		Usage: "BLS consensus key as hex (for testing)",
+	}
+	ConsensusSignerFlag = cli.StringFlag{
+		Name:  "consensussigner",
+		Usage: "External signer holding the hotstuff validator keys (path to clef's ipc file)",
+	}
 	NATFlag = cli.StringFlag{
 		Name:  "nat",
 		Usage: "NAT port mapping mechanism (any|none|upnp|pmp|extip:<IP>)",
@@ -837,6 +855,36 @@ func setNodeKey(ctx *cli.Context, cfg *p2p.Config) {
 	}
 }
 
+// setConsensusKey loads the BLS consensus key from set command line flags, either
+// decrypting it from a key file or as a specified hex value. If neither flags
+// were provided, the key stored in the data directory is used. The password of
+// the key is the first line of the --password file, if any.
+func setConsensusKey(ctx *cli.Context, cfg *node.Config) {
+	var (
+		hex  = ctx.GlobalString(ConsensusKeyHexFlag.Name)
+		file = ctx.GlobalString(ConsensusKeyFileFlag.Name)
+		key  blscommon.SecretKey
+		err  error
+	)
+	if passwords := MakePasswordList(ctx); len(passwords) > 0 {
+		cfg.ConsensusKeyPassword = passwords[0]
+	}
+	switch {
+	case file != "" && hex != "":
+		Fatalf("Options %q and %q are mutually exclusive", ConsensusKeyFileFlag.Name, ConsensusKeyHexFlag.Name)
+	case file != "":
+		if key, err = keystore.LoadBLSKey(file, cfg.ConsensusKeyPassword); err != nil {
+			Fatalf("Option %q: %v", ConsensusKeyFileFlag.Name, err)
+		}
+		cfg.ConsensusPrivateKey = key
+	case hex != "":
+		if key, err = blst.SecretKeyFromBytes(common.FromHex(hex)); err != nil {
+			Fatalf("Option %q: %v", ConsensusKeyHexFlag.Name, err)
+		}
+		cfg.ConsensusPrivateKey = key
+	}
+}
+
 // setNodeUserIdent creates the user identifier from CLI flags.
 func setNodeUserIdent(ctx *cli.Context, cfg *node.Config) {
 	if identity := ctx.GlobalString(IdentityFlag.Name); len(identity) > 0 {
@@ -1211,10 +1259,14 @@ func SetNodeConfig(ctx *cli.Context, cfg *node.Config) {
 	setNodeUserIdent(ctx, cfg)
 	setDataDir(ctx, cfg)
 	setSmartCard(ctx, cfg)
+	setConsensusKey(ctx, cfg)
 
 	if ctx.GlobalIsSet(ExternalSignerFlag.Name) {
 		cfg.ExternalSigner = ctx.GlobalString(ExternalSignerFlag.Name)
 	}
+	if ctx.GlobalIsSet(ConsensusSignerFlag.Name) {
+		cfg.ConsensusSigner = ctx.GlobalString(ConsensusSignerFlag.Name)
+	}
 
 	if ctx.GlobalIsSet(KeyStoreDirFlag.Name) {
 		cfg.KeyStoreDir = ctx.GlobalString(KeyStoreDirFlag.Name)
@@ -1468,6 +1520,8 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 	CheckExclusive(ctx, MainnetFlag, DeveloperFlag, RopstenFlag, RinkebyFlag, GoerliFlag, SepoliaFlag)
 	CheckExclusive(ctx, LightServeFlag, SyncModeFlag, "light")
 	CheckExclusive(ctx, DeveloperFlag, ExternalSignerFlag) // Can't use both ephemeral unlocked and external signer
+	CheckExclusive(ctx, DeveloperFlag, ConsensusSignerFlag)
+	CheckExclusive(ctx, ConsensusSignerFlag, ConsensusKeyFileFlag, ConsensusKeyHexFlag)
 	if ctx.GlobalString(GCModeFlag.Name) == "archive" && ctx.GlobalUint64(TxLookupLimitFlag.Name) != 0 {
 		ctx.GlobalSet(TxLookupLimitFlag.Name, "0")
 		log.Warn("Disable transaction unindexing for archive node")
@@ -1661,7 +1715,20 @@ func SetEthConfig(ctx *cli.Context, stack *node.Node, cfg *ethconfig.Config) {
 		log.Info("Using developer account", "address", developer.Address)
 
 		// Create a new developer genesis block or reuse existing one
-		cfg.Genesis = core.DeveloperGenesisBlock(uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name)), developer.Address)
+		period := uint64(ctx.GlobalInt(DeveloperPeriodFlag.Name))
+		if ctx.GlobalBool(DeveloperHotstuffFlag.Name) {
+			// The node and consensus keys are ephemeral without a datadir, pin them
+			// so the engine signs with the keys of the genesis validator.
+			conf := stack.Config()
+			conf.P2P.PrivateKey = stack.Server().PrivateKey
+			conf.ConsensusPrivateKey = *conf.ConsensusKey()
+
+			validator := crypto.PubkeyToAddress(conf.P2P.PrivateKey.PublicKey)
+			log.Info("Using developer hotstuff validator", "address", validator)
+			cfg.Genesis = core.DeveloperHotstuffGenesisBlock(period, validator, conf.ConsensusPrivateKey.PublicKey().Marshal(), developer.Address)
+		} else {
+			cfg.Genesis = core.DeveloperGenesisBlock(period, developer.Address)
+		}
 		if ctx.GlobalIsSet(DataDirFlag.Name) {
 			// Check if we have an already initialized chain and fall back to
 			// that if so. Otherwise we need to generate a new genesis spec.
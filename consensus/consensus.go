@@ -23,6 +23,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 )
@@ -87,7 +88,7 @@ type Engine interface {
 	// Note: The block header and state database might be updated to reflect any
 	// consensus rules that happen at finalization (e.g. block rewards).
 	Finalize(chain ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
-		uncles []*types.Header)
+		uncles []*types.Header) error
 
 	// FinalizeAndAssemble runs any post-transaction state modifications (e.g. block
 	// rewards) and assembles the final block.
@@ -126,7 +127,7 @@ type PoW interface {
 	Hashrate() float64
 }
 
-// Hotstuff is a consensus engine based on proof-of-work.
+// Hotstuff is a consensus engine based on byzantine fault tolerant voting.
 type Hotstuff interface {
 	Engine
 
@@ -135,4 +136,30 @@ type Hotstuff interface {
 
 	// Stop stops the engine
 	Stop() error
+
+	// Address returns the validator address of the local node.
+	Address() common.Address
+
+	// CurrentView returns the height and round the local validator is working on
+	// and the phase it reached, ok being false if the consensus is not running.
+	CurrentView() (height *big.Int, round *big.Int, phase string, ok bool)
+
+	// ValidatorsAt retrieves the validators sealing the child of the given header.
+	ValidatorsAt(chain ChainHeaderReader, header *types.Header) ([]common.Address, error)
+
+	// Participants retrieves the validators whose votes are aggregated in the
+	// quorum certificate sealed into the given header.
+	Participants(chain ChainHeaderReader, header *types.Header) ([]common.Address, error)
+}
+
+// CertifiedChain is implemented by the engines extending blocks which are certified
+// but not committed to the canonical chain yet, such as chained hotstuff.
+type CertifiedChain interface {
+	// CertifiedHead returns the block the next one extends, which is the latest
+	// certified descendant of the given canonical head, or else the head itself.
+	CertifiedHead(head *types.Block) *types.Block
+
+	// SubscribeCertifiedHead notifies about the blocks certified on top of the
+	// canonical chain.
+	SubscribeCertifiedHead(ch chan<- *types.Block) event.Subscription
 }
@@ -1,13 +1,17 @@
 package config
 
-import "github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/params"
+)
 
 type Config struct {
 	RequestTimeout uint64                          `toml:",omitempty"` // The timeout for each Istanbul round in milliseconds.
-	BlockPeriod    uint64                          `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in second for basic hotstuff and mill-seconds for event-driven
+	BlockPeriod    uint64                          `toml:",omitempty"` // Default minimum difference between two consecutive block's timestamps in seconds
 	LeaderPolicy   interfaces.SelectProposerPolicy `toml:",omitempty"` // The policy for speaker selection
 	Test           bool                            `toml:",omitempty"`
 	Epoch          uint64                          `toml:",omitempty"` // The number of blocks after which to checkpoint and reset the pending votes
+	Chained        bool                            `toml:",omitempty"` // Whether to run the chained protocol, where each proposal carries the QC of its parent
 }
 
 // todo: modify request timeout, and miner recommit default value is 3s. recommit time should be > blockPeriod
@@ -21,8 +25,33 @@ var DefaultBasicConfig = &Config{
 
 var DefaultEventDrivenConfig = &Config{
 	RequestTimeout: 4000,
-	BlockPeriod:    2000,
+	BlockPeriod:    2,
 	LeaderPolicy:   interfaces.RoundRobin,
-	Epoch:          0,
+	Epoch:          30000,
+	Chained:        true,
 	Test:           false,
 }
+
+// FromChainConfig returns the engine config of the chain, falling back to the
+// basic defaults for the settings the chain leaves empty.
+func FromChainConfig(conf *params.HotStuffConfig) *Config {
+	cfg := *DefaultBasicConfig
+	if conf == nil {
+		return &cfg
+	}
+	if conf.Period != 0 {
+		cfg.BlockPeriod = conf.Period
+	}
+	if conf.Instant {
+		cfg.BlockPeriod = 0
+	}
+	if conf.RequestTimeout != 0 {
+		cfg.RequestTimeout = conf.RequestTimeout
+	}
+	if conf.Epoch != 0 {
+		cfg.Epoch = conf.Epoch
+	}
+	cfg.LeaderPolicy = interfaces.SelectProposerPolicy(conf.ElectPolicy)
+	cfg.Chained = conf.Chained
+	return &cfg
+}
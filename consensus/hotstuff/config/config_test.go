@@ -0,0 +1,25 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the default engine configs are the ones of the chain configs
+// carrying their settings.
+func TestDefaultsFromChainConfig(t *testing.T) {
+	for name, conf := range map[string]*Config{"basic": DefaultBasicConfig, "event-driven": DefaultEventDrivenConfig} {
+		chainConf := &params.HotStuffConfig{
+			Period:         conf.BlockPeriod,
+			RequestTimeout: conf.RequestTimeout,
+			Epoch:          conf.Epoch,
+			ElectPolicy:    uint64(conf.LeaderPolicy),
+			Chained:        conf.Chained,
+		}
+		if have := FromChainConfig(chainConf); !reflect.DeepEqual(have, conf) {
+			t.Errorf("%s: config mismatch: have %+v, want %+v", name, have, conf)
+		}
+	}
+}
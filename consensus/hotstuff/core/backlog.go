@@ -0,0 +1,95 @@
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/prque"
+)
+
+var (
+	// msgPriority is defined for calculating processing priority to speedup consensus
+	// msgPrepare > msgPreCommit > msgCommit > msgDecide
+	msgPriority = map[MsgType]int{
+		MsgTypeNewView:       1,
+		MsgTypePrepare:       2,
+		MsgTypePrepareVote:   3,
+		MsgTypePreCommit:     4,
+		MsgTypePreCommitVote: 5,
+		MsgTypeCommit:        6,
+		MsgTypeCommitVote:    7,
+		MsgTypeDecide:        8,
+		MsgTypeGenericVote:   3,
+	}
+
+	// maxRound bounds the rounds of a height when ordering backlog messages
+	maxRound = big.NewInt(1 << 16)
+)
+
+// storeBacklog keeps a message of a future view, it is replayed by processBacklog
+// once the local view catches up.
+func (c *Core) storeBacklog(msg *Message) {
+	logger := c.newLogger()
+
+	if msg.Address == c.Address() {
+		logger.Warn("Backlog from self")
+		return
+	}
+
+	logger.Trace("Store future message", "msg", msg)
+
+	c.backlogsMu.Lock()
+	defer c.backlogsMu.Unlock()
+
+	backlog := c.backlogs[msg.Address]
+	if backlog == nil {
+		backlog = prque.New(nil)
+		c.backlogs[msg.Address] = backlog
+	}
+	backlog.Push(msg, toPriority(msg.Code, msg.View))
+	c.updateBacklogGauge()
+}
+
+// processBacklog replays the stored messages whose view is reachable now and drops
+// the outdated ones.
+func (c *Core) processBacklog() {
+	c.backlogsMu.Lock()
+	defer c.backlogsMu.Unlock()
+
+	for addr, backlog := range c.backlogs {
+		if backlog == nil {
+			continue
+		}
+		if _, v := c.valSet.GetByAddress(addr); v == nil {
+			delete(c.backlogs, addr)
+			continue
+		}
+		logger := c.newLogger().New("from", addr)
+		isFuture := false
+
+		// We stop processing if
+		//   1. backlog is empty
+		//   2. The first message in queue is a future message
+		for !(backlog.Empty() || isFuture) {
+			m, prio := backlog.Pop()
+			msg := m.(*Message)
+			if err := c.checkView(msg.Code, msg.View); err != nil {
+				if err == errFutureMessage {
+					backlog.Push(msg, prio)
+					isFuture = true
+					break
+				}
+				logger.Trace("Skip the backlog event", "msg", msg, "err", err)
+				continue
+			}
+			logger.Trace("Post backlog event", "msg", msg)
+
+			go c.sendEvent(backlogEvent{msg: msg})
+		}
+	}
+	c.updateBacklogGauge()
+}
+
+func toPriority(code MsgType, view *View) int64 {
+	// messages of lower views go first, and within a view the earlier phase goes first
+	return -(view.Height.Int64()*maxRound.Int64()+view.Round.Int64())*10 - int64(msgPriority[code])
+}
@@ -1,38 +1,64 @@
 package core
 
 import (
+	"math/big"
+
 	common2 "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/ethdb"
+	lru "github.com/hashicorp/golang-lru"
 	blst "github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
-	"strconv"
 )
 
+// inmemoryValidatorKeys is the number of validator sets whose deserialized
+// consensus keys are kept in memory.
+const inmemoryValidatorKeys = 16
+
 type BlsSigner struct {
 	ConsensusKey       *common.SecretKey
 	ConsensusPublicKey *common.PublicKey
 	db                 ethdb.Database
 	ValidatorNo        int
 	aggSignatures      common.Signature
+
+	validatorKeys *lru.ARCCache // Consensus keys of recent validator sets, keyed by the hash of their addresses and keys
+	remote        RemoteSigner  // Signer holding the consensus key instead of ConsensusKey, if any
 }
 
 var (
-	dst                      = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
-	ConsensusPublicKeyPrefix = "bls-public-key"
+	dst = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
 )
 
 func NewBlsSigner(consensusKey *common.SecretKey, db ethdb.Database) *BlsSigner {
 	pk := (*consensusKey).PublicKey()
+	validatorKeys, _ := lru.NewARC(inmemoryValidatorKeys)
 	return &BlsSigner{
 		ConsensusKey:       consensusKey,
 		ConsensusPublicKey: &pk,
 		db:                 db,
+		validatorKeys:      validatorKeys,
 	}
 
 }
 
+// NewRemoteBlsSigner creates a signer leaving the consensus key to the remote signer.
+func NewRemoteBlsSigner(remote RemoteSigner, db ethdb.Database) (*BlsSigner, error) {
+	pk, err := blst.PublicKeyFromBytes(remote.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	validatorKeys, _ := lru.NewARC(inmemoryValidatorKeys)
+	return &BlsSigner{
+		ConsensusPublicKey: &pk,
+		db:                 db,
+		validatorKeys:      validatorKeys,
+		remote:             remote,
+	}, nil
+}
+
 func generateKey() (common.SecretKey, error) {
 	return blst.RandKey()
 }
@@ -41,6 +67,24 @@ func (blsSigner *BlsSigner) Sign(msg []byte) common.Signature {
 	return (*blsSigner.ConsensusKey).Sign(msg)
 }
 
+// SignVote signs the vote of the given phase of the view for the digest.
+func (blsSigner *BlsSigner) SignVote(code MsgType, view *View, digest common2.Hash) ([]byte, error) {
+	if blsSigner.remote != nil {
+		return blsSigner.remote.SignVote(code, view, digest)
+	}
+	return blsSigner.Sign(VoteDigest(code, view, digest).Bytes()).Marshal(), nil
+}
+
+// SignVRF signs the proof of the election of the proposer in the given round,
+// on top of the given parent.
+func (blsSigner *BlsSigner) SignVRF(parent common2.Hash, round uint64) ([]byte, error) {
+	if blsSigner.remote != nil {
+		return blsSigner.remote.SignVRF(parent, round)
+	}
+	msg := VRFMessage(parent, round)
+	return blsSigner.Sign(msg[:]).Marshal(), nil
+}
+
 func (blsSigner *BlsSigner) AggregateSignatures(sigs []common.Signature) common.Signature {
 	return blst.AggregateSignatures(sigs)
 }
@@ -49,65 +93,198 @@ func (blsSigner *BlsSigner) FastAggregateVerify(pubKeys []common.PublicKey, msg
 	return blsSigner.aggSignatures.FastAggregateVerify(pubKeys, msg)
 }
 
-// Marshal a secret key into a LittleEndian byte slice.
+// Marshal a secret key into a LittleEndian byte slice, nil if the key is held
+// by a remote signer.
 func (blsSigner *BlsSigner) Marshal() []byte {
+	if blsSigner.ConsensusKey == nil {
+		return nil
+	}
 	return (*blsSigner.ConsensusKey).Marshal()
 }
 
-// Marshal a secret key into a LittleEndian byte slice.
-func (blsSigner *BlsSigner) ConsenesusKeyFromBytes(priv []byte) (err error) {
-	*blsSigner.ConsensusKey, err = blst.SecretKeyFromBytes(priv)
-	return err
+// ConsenesusKeyFromBytes replaces the consensus key, and its public key, with the
+// one unmarshaled from the LittleEndian byte slice.
+func (blsSigner *BlsSigner) ConsenesusKeyFromBytes(priv []byte) error {
+	key, err := blst.SecretKeyFromBytes(priv)
+	if err != nil {
+		return err
+	}
+	pk := key.PublicKey()
+	blsSigner.ConsensusKey, blsSigner.ConsensusPublicKey = &key, &pk
+	return nil
 }
 
-func (blsSigner *BlsSigner) StoreConsensusPublicKeyList(pubKeys []common.PublicKey) {
-	for k, pubKey := range pubKeys {
-		blsSigner.StoreConsensusPublicKey([]byte(strconv.Itoa(k)), pubKey.Marshal())
+func (blsSigner *BlsSigner) VerifyValidatorSeal(header *types.Header, valSet interfaces.ValidatorSet, chained bool) error {
+	seal, err := blsSigner.ValidatorSeal(header, valSet, chained)
+	if err != nil {
+		return err
 	}
+	return seal.Verify()
 }
 
-func (blsSigner *BlsSigner) GetConsensusPublicKey(keyIndex int) ([]byte, error) {
-	storeKey := append([]byte(ConsensusPublicKeyPrefix), []byte(strconv.Itoa(keyIndex))...)
-	return blsSigner.db.Get(storeKey)
+// AggregatedSeal is the aggregated signature of a quorum of validators over the
+// digest of a header, ready to be verified alone or along other seals.
+type AggregatedSeal struct {
+	PublicKey common.PublicKey // Aggregation of the consensus keys of the participants
+	Signature []byte
+	Digest    common2.Hash
 }
 
-func (blsSigner *BlsSigner) StoreConsensusPublicKey(k []byte, pubKey []byte) {
-	blsSigner.db.Put(append([]byte(ConsensusPublicKeyPrefix), k...), pubKey)
+// Verify checks that the seal is the signature of the digest by the aggregated key.
+func (seal *AggregatedSeal) Verify() error {
+	signature, err := blst.SignatureFromBytes(seal.Signature)
+	if err != nil {
+		return errInvalidValidatorSeals
+	}
+	if !signature.Verify(seal.PublicKey, seal.Digest[:]) {
+		return errInvalidValidatorSeals
+	}
+	return nil
 }
 
-func (blsSigner *BlsSigner) VerifyValidatorSeal(header *types.Header, valSet interfaces.ValidatorSet) error {
+// VerifyAggregatedSeals verifies the seals at once with a random linear combination
+// of them, only verifying them one by one to find out the invalid ones if the
+// batch fails. The returned errors are ordered as the seals.
+func VerifyAggregatedSeals(seals []*AggregatedSeal) []error {
+	errs := make([]error, len(seals))
+	if len(seals) > 1 {
+		var (
+			sigs = make([][]byte, len(seals))
+			msgs = make([][32]byte, len(seals))
+			keys = make([]common.PublicKey, len(seals))
+		)
+		for i, seal := range seals {
+			sigs[i], msgs[i], keys[i] = seal.Signature, seal.Digest, seal.PublicKey
+		}
+		if ok, err := blst.VerifyMultipleSignatures(sigs, msgs, keys); err == nil && ok {
+			return errs
+		}
+	}
+	for i, seal := range seals {
+		errs[i] = seal.Verify()
+	}
+	return errs
+}
 
+// ValidatorSeal checks that the participants of the aggregated seal of the header
+// are a quorum of the validator set, and returns the seal to verify, which is the
+// aggregation of the votes of the chained or basic mode in the recorded view.
+func (blsSigner *BlsSigner) ValidatorSeal(header *types.Header, valSet interfaces.ValidatorSet, chained bool) (*AggregatedSeal, error) {
 	extra, err := types.ExtractHotstuffExtra(header)
 	if err != nil {
-		return errInvalidExtraDataFormat
+		return nil, errInvalidExtraDataFormat
 	}
 
 	// The length of Committed seals should be larger than 0
 	if len(extra.AggregatedValidatorsSeal) == 0 {
-		return errEmptyCommittedSeals
+		return nil, errEmptyCommittedSeals
 	}
 
-	ValidatorList := extra.ParticipantsIndex
-	if len(ValidatorList) < valSet.Q() {
-		return errInvalidValidatorSeals
+	// The bitmap must cover exactly the validator set, and flag a quorum of it
+	if err := extra.Participants.Validate(valSet.Size()); err != nil {
+		return nil, err
+	}
+	indices := extra.Participants.Indices()
+	if len(indices) < valSet.Q() {
+		return nil, errInvalidValidatorSeals
+	}
+	keys, err := blsSigner.validatorSetKeys(valSet)
+	if err != nil {
+		return nil, errInvalidValidatorSeals
+	}
+	participants := make([]common.PublicKey, len(indices))
+	for i, index := range indices {
+		participants[i] = keys[index]
 	}
+	return &AggregatedSeal{
+		PublicKey: blst.AggregateMultiplePubkeys(participants),
+		Signature: extra.AggregatedValidatorsSeal,
+		Digest:    SealDigest(header, extra.Round, chained),
+	}, nil
+}
+
+// SealDigest returns the message the validators BLS-sign to seal the header with
+// a QC of the given round, in the chained or basic mode.
+func SealDigest(header *types.Header, round uint64, chained bool) common2.Hash {
+	view := &View{Height: header.Number, Round: new(big.Int).SetUint64(round)}
+	return VoteDigest(SealCode(chained), view, types.HotstuffFilteredHeader(header, true).Hash())
+}
 
-	pubkeys := make([]common.PublicKey, len(ValidatorList))
-	for i := 0; i < len(ValidatorList); i++ {
-		pk, err := blsSigner.GetConsensusPublicKey(i)
-		pubKey, err := blst.PublicKeyFromBytes(pk)
+// validatorSetKeys returns the consensus keys of the validators of the set,
+// ordered by their index.
+func (blsSigner *BlsSigner) validatorSetKeys(valSet interfaces.ValidatorSet) ([]common.PublicKey, error) {
+	validators := valSet.List()
+	blob := make([]byte, 0, len(validators)*common2.AddressLength)
+	for _, val := range validators {
+		addr := val.Address()
+		blob = append(blob, addr[:]...)
+		blob = append(blob, val.PublicKey()...)
+	}
+	hash := crypto.Keccak256Hash(blob)
+	if keys, ok := blsSigner.validatorKeys.Get(hash); ok {
+		return keys.([]common.PublicKey), nil
+	}
+	keys := make([]common.PublicKey, len(validators))
+	for i, val := range validators {
+		key, err := consensusPublicKey(val)
 		if err != nil {
-			return errValidatorNotFound
+			return nil, err
 		}
-		pubkeys[i] = pubKey
+		keys[i] = key
 	}
+	blsSigner.validatorKeys.Add(hash, keys)
+	return keys, nil
+}
 
-	if blsSigner.aggSignatures, err = blst.SignatureFromBytes(extra.AggregatedValidatorsSeal); err != nil {
+// VerifySignature checks that sig is the signature of msg by the consensus key
+// of the validator of the set with the given address.
+func (blsSigner *BlsSigner) VerifySignature(valSet interfaces.ValidatorSet, addr common2.Address, sig []byte, msg common2.Hash) error {
+	_, val := valSet.GetByAddress(addr)
+	pubKey, err := consensusPublicKey(val)
+	if err != nil {
 		return err
 	}
+	signature, err := blst.SignatureFromBytes(sig)
+	if err != nil {
+		return errInvalidSignature
+	}
+	if !signature.Verify(pubKey, msg[:]) {
+		return errInvalidSignature
+	}
+	return nil
+}
 
-	if res := blsSigner.FastAggregateVerify(pubkeys, header.Hash()); res {
-		return nil
+// VerifyAggregatedSignature checks that sig is the aggregation of the signatures
+// of msg by the consensus keys of the validators of the set with the given addresses.
+func (blsSigner *BlsSigner) VerifyAggregatedSignature(valSet interfaces.ValidatorSet, addrs []common2.Address, sig []byte, msg common2.Hash) error {
+	pubKeys := make([]common.PublicKey, len(addrs))
+	for i, addr := range addrs {
+		_, val := valSet.GetByAddress(addr)
+		pubKey, err := consensusPublicKey(val)
+		if err != nil {
+			return err
+		}
+		pubKeys[i] = pubKey
+	}
+	signature, err := blst.SignatureFromBytes(sig)
+	if err != nil {
+		return errInvalidSignature
+	}
+	if !signature.FastAggregateVerify(pubKeys, msg) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// consensusPublicKey deserializes the consensus key of the validator, which the
+// snapshot the validator set was built from recorded.
+func consensusPublicKey(val interfaces.Validator) (common.PublicKey, error) {
+	if val == nil || len(val.PublicKey()) == 0 {
+		return nil, errValidatorNotFound
+	}
+	pubKey, err := blst.PublicKeyFromBytes(val.PublicKey())
+	if err != nil {
+		return nil, errValidatorNotFound
 	}
-	return errInvalidValidatorSeals
+	return pubKey, nil
 }
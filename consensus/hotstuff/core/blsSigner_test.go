@@ -1,12 +1,20 @@
 package core
 
 import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	common2 "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
 	blst "github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
 	"github.com/stretchr/testify/assert"
-	"reflect"
-	"testing"
 )
 
 func TestBlsSignVerify(t *testing.T) {
@@ -23,6 +31,20 @@ func TestBlsSignVerify(t *testing.T) {
 	//t.Log(sig.Verify(false, BlsSigner.ConsensusPublicKey, false, wrongMsg, dst))
 }
 
+func TestConsensusKeyFromBytes(t *testing.T) {
+	sk, _ := generateKey()
+	other, _ := generateKey()
+	blsSigner := NewBlsSigner(&sk, nil)
+	orig := sk.Marshal()
+
+	assert.NoError(t, blsSigner.ConsenesusKeyFromBytes(other.Marshal()))
+	assert.Equal(t, other.Marshal(), blsSigner.Marshal())
+	assert.Equal(t, other.PublicKey().Marshal(), (*blsSigner.ConsensusPublicKey).Marshal())
+	assert.Equal(t, orig, sk.Marshal(), "original key must be left untouched")
+
+	assert.Error(t, blsSigner.ConsenesusKeyFromBytes([]byte{0x01}))
+}
+
 func TestBlsAggregateSignVerify(t *testing.T) {
 
 	msg := [32]byte{0x11, 0x22}
@@ -54,3 +76,72 @@ func TestBlsAggregateSignVerify(t *testing.T) {
 	wrongRes := testSuite[0].signer.FastAggregateVerify(pubKeys, wrongMsg)
 	assert.Equal(t, false, wrongRes, "verify passed")
 }
+
+func TestVerifyValidatorSeal(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	keys := make(map[common2.Address]common.SecretKey)
+	pubKeys := make(map[common2.Address][]byte)
+	addrs := make([]common2.Address, 0, 4)
+	for i := 0; i < 4; i++ {
+		sk, _ := generateKey()
+		addr := common2.BytesToAddress(crypto.Keccak256(sk.PublicKey().Marshal()))
+		keys[addr] = sk
+		pubKeys[addr] = sk.PublicKey().Marshal()
+		addrs = append(addrs, addr)
+	}
+	sk := keys[addrs[0]]
+	verifier := NewBlsSigner(&sk, db)
+	valSet := validator.NewSetWithPublicKeys(addrs, pubKeys, interfaces.RoundRobin)
+
+	// seal returns the header sealed with commit votes in round 1 by the validators
+	// at the given indices, flagged in a bitmap of the given size.
+	seal := func(size int, indices ...int) *types.Header {
+		header := &types.Header{Number: big.NewInt(1)}
+		if err := types.HotstuffHeaderFillWithValidators(header, nil); err != nil {
+			t.Fatalf("failed to fill header extra: %v", err)
+		}
+		hash := SealDigest(header, 1, false)
+
+		extra, _ := types.ExtractHotstuffExtra(header)
+		extra.Round = 1
+		extra.Participants = types.NewHotstuffBitmap(size)
+		sigs := make([]common.Signature, 0, len(indices))
+		for _, index := range indices {
+			extra.Participants.Set(index)
+			if val := valSet.GetByIndex(uint64(index)); val != nil {
+				sigs = append(sigs, keys[val.Address()].Sign(hash[:]))
+			}
+		}
+		extra.AggregatedValidatorsSeal = blst.AggregateSignatures(sigs).Marshal()
+		payload, _ := rlp.EncodeToBytes(&extra)
+		header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+		return header
+	}
+	// A quorum which is not a prefix of the validator set
+	assert.NoError(t, verifier.VerifyValidatorSeal(seal(4, 1, 2, 3), valSet, false))
+
+	// The seal is bound to the code and the view of the votes
+	assert.Equal(t, errInvalidValidatorSeals, verifier.VerifyValidatorSeal(seal(4, 1, 2, 3), valSet, true))
+
+	header := seal(4, 1, 2, 3)
+	extra, _ := types.ExtractHotstuffExtra(header)
+	extra.Round = 2
+	payload, _ := rlp.EncodeToBytes(&extra)
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	assert.Equal(t, errInvalidValidatorSeals, verifier.VerifyValidatorSeal(header, valSet, false))
+
+	// Seals aggregated from other validators than the flagged ones
+	header = seal(4, 1, 2, 3)
+	extra, _ = types.ExtractHotstuffExtra(header)
+	extra.Participants = types.HotstuffBitmap{0x07}
+	payload, _ = rlp.EncodeToBytes(&extra)
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	assert.Equal(t, errInvalidValidatorSeals, verifier.VerifyValidatorSeal(header, valSet, false))
+
+	// Less than a quorum
+	assert.Equal(t, errInvalidValidatorSeals, verifier.VerifyValidatorSeal(seal(4, 0, 3), valSet, false))
+
+	// Bitmaps not matching the validator set
+	assert.Equal(t, types.ErrInvalidHotstuffBitmap, verifier.VerifyValidatorSeal(seal(16, 0, 1, 2), valSet, false))
+	assert.Equal(t, types.ErrInvalidHotstuffBitmap, verifier.VerifyValidatorSeal(seal(5, 0, 1, 4), valSet, false))
+}
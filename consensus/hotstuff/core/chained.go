@@ -0,0 +1,276 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// checkProtocol rejects the messages of the protocol the core doesn't run: the
+// chained mode replaces the votes of the three phases, and the PRECOMMIT, COMMIT
+// and DECIDE messages, with a single generic vote per view.
+func (c *Core) checkProtocol(code MsgType) error {
+	switch code {
+	case MsgTypePrepareVote, MsgTypePreCommit, MsgTypePreCommitVote, MsgTypeCommit, MsgTypeCommitVote, MsgTypeDecide:
+		if c.config.Chained {
+			return errInvalidMessage
+		}
+	case MsgTypeGenericVote:
+		if !c.config.Chained {
+			return errInvalidMessage
+		}
+	}
+	return nil
+}
+
+// certifiedBlock is a block certified by a generic QC, which is not committed
+// yet. It is kept sealed, as written to the local chain.
+type certifiedBlock struct {
+	block *types.Block
+	qc    *QuorumCert
+}
+
+// checkChainedSafety implements the safe node predicate of chained hotstuff: the
+// high QC of the proposal must certify its parent, and the proposal must extend
+// the block the replica is locked on, unless its QC is formed in a later view
+// than the lock. The view of the QC is signed by its voters, so it can't be
+// raised past the lock by the proposer. It returns the certified parent of the
+// proposal.
+func (c *Core) checkChainedSafety(proposal *types.Block, highQC *QuorumCert) (*types.Block, error) {
+	parent := c.blockByHash(proposal.ParentHash(), proposal.NumberU64()-1)
+	if parent == nil {
+		return nil, errUnsafeProposal
+	}
+	// only the children of the genesis block are proposed without a QC
+	if highQC == nil {
+		if parent.NumberU64() != 0 {
+			return nil, errUnsafeProposal
+		}
+		return parent, nil
+	}
+	if highQC.View.Height.Cmp(parent.Number()) != 0 || highQC.Hash != unsealedHash(parent) {
+		return nil, errUnsafeProposal
+	}
+	lockedQC := c.current.LockedQC()
+	if lockedQC == nil || highQC.View.Cmp(lockedQC.View) > 0 {
+		return parent, nil
+	}
+	ancestor := parent
+	for ancestor != nil && ancestor.Number().Cmp(lockedQC.View.Height) > 0 {
+		ancestor = c.blockByHash(ancestor.ParentHash(), ancestor.NumberU64()-1)
+	}
+	if ancestor == nil || unsealedHash(ancestor) != lockedQC.Hash {
+		return nil, errUnsafeProposal
+	}
+	return parent, nil
+}
+
+// certify seals the generic QC into the proposal it certifies, and extends the
+// chain with the block. The next height is started once the block is written to
+// the chain and reported by a FinalCommittedEvent.
+func (c *Core) certify(proposal *types.Block, qc *QuorumCert) error {
+	logger := c.newLogger()
+
+	c.current.SetCommitQC(qc)
+
+	sealed, err := c.backend.PreCommit(proposal, qc.View.Round.Uint64(), qc.Signers, qc.Seal)
+	if err != nil {
+		logger.Error("Failed to seal proposal", "hash", qc.Hash, "err", err)
+		return err
+	}
+	block, ok := sealed.(*types.Block)
+	if !ok {
+		logger.Error("Invalid sealed proposal", "hash", sealed.Hash())
+		return errInvalidProposal
+	}
+	if err := c.updateQC(block, qc); err != nil {
+		logger.Error("Failed to certify proposal", "hash", qc.Hash, "err", err)
+		return err
+	}
+
+	c.current.SetDecided(block.Hash())
+	c.setState(StateDecided)
+	logger.Debug("Certified", "number", block.Number(), "hash", block.Hash(), "signers", len(qc.Signers))
+	return nil
+}
+
+// updateQC extends the certified blocks with the block certified by the QC, and
+// writes it to the local chain unless it is already the head. The replica then
+// locks on the QC of the parent of the block, the two-chain, and commits its
+// grandparent, along with the certified blocks before it, when the three of them
+// form a direct chain: parent links certified in consecutive views.
+func (c *Core) updateQC(block *types.Block, qc *QuorumCert) error {
+	for _, certified := range c.certified {
+		if certified.block.Hash() == block.Hash() {
+			return nil
+		}
+	}
+	if head, _ := c.backend.LastProposal(); head.Hash() != block.Hash() {
+		if err := c.backend.Certify(block); err != nil {
+			return err
+		}
+	}
+	// Only the branch of the block is kept, every block linked to its parent
+	branch := c.certified[:0]
+	for i, certified := range c.certified {
+		if certified.block.Hash() == block.ParentHash() {
+			branch = c.certified[:i+1]
+			break
+		}
+	}
+	c.certified = append(branch, &certifiedBlock{block: block, qc: qc})
+
+	if prepareQC := c.current.PrepareQC(); prepareQC == nil || qc.View.Cmp(prepareQC.View) > 0 {
+		c.current.SetPrepareQC(qc)
+	}
+	n := len(c.certified)
+	if n >= 2 {
+		if lockedQC := c.current.LockedQC(); lockedQC == nil || c.certified[n-2].qc.View.Cmp(lockedQC.View) > 0 {
+			c.current.SetLockedQC(c.certified[n-2].qc)
+		}
+	}
+	if n >= 3 && c.certified[n-2].qc.View.succeeds(c.certified[n-3].qc.View) && qc.View.succeeds(c.certified[n-2].qc.View) {
+		committed := c.certified[:n-2]
+		c.certified = c.certified[n-2:]
+		for _, certified := range committed {
+			if err := c.backend.Commit(certified.block); err != nil {
+				return err
+			}
+			c.newLogger().Debug("Committed", "number", certified.block.Number(), "hash", certified.block.Hash())
+		}
+	}
+	return nil
+}
+
+// headQC returns the QC justifying the next proposal, which certifies the head
+// of the local chain: the one held by the replica, or else the one rebuilt from
+// the seals and the round recorded in the head, if it was not certified by the
+// local replica.
+func (c *Core) headQC() *QuorumCert {
+	proposal, _ := c.backend.LastProposal()
+	head, ok := proposal.(*types.Block)
+	if !ok || head.NumberU64() == 0 {
+		return nil
+	}
+	hash := unsealedHash(head)
+	if qc := c.current.PrepareQC(); qc != nil && qc.Hash == hash {
+		return qc
+	}
+	extra, err := types.ExtractHotstuffExtra(head.Header())
+	if err != nil {
+		return nil
+	}
+	valSet := c.backend.Validators(head.NumberU64())
+	if err := extra.Participants.Validate(valSet.Size()); err != nil {
+		return nil
+	}
+	indices := extra.Participants.Indices()
+	signers := make([]common.Address, 0, len(indices))
+	for _, index := range indices {
+		signers = append(signers, valSet.GetByIndex(uint64(index)).Address())
+	}
+	return &QuorumCert{
+		View:     &View{Height: head.Number(), Round: new(big.Int).SetUint64(extra.Round)},
+		Code:     MsgTypeGenericVote,
+		Hash:     hash,
+		Proposer: head.Coinbase(),
+		Signers:  signers,
+		Seal:     extra.AggregatedValidatorsSeal,
+	}
+}
+
+// blockByHash retrieves a block which may only be certified so far, looking up
+// the certified blocks before the local chain.
+func (c *Core) blockByHash(hash common.Hash, number uint64) *types.Block {
+	for _, certified := range c.certified {
+		if certified.block.Hash() == hash {
+			return certified.block
+		}
+	}
+	if head, _ := c.backend.LastProposal(); head.Hash() == hash {
+		block, _ := head.(*types.Block)
+		return block
+	}
+	if c.chain == nil {
+		return nil
+	}
+	return c.chain.GetBlock(hash, number)
+}
+
+// unsealedHash returns the hash of the proposal the block was sealed from, which
+// is the one its QC certifies.
+func unsealedHash(block *types.Block) common.Hash {
+	if header := types.HotstuffFilteredHeader(block.Header(), true); header != nil {
+		return header.Hash()
+	}
+	return block.Hash()
+}
+
+// nextValidators returns the validator set of the child of the proposal, with
+// the proposer of its first round elected.
+func (c *Core) nextValidators(proposal *types.Block) interfaces.ValidatorSet {
+	valSet := c.backend.NextValidators(proposal)
+	valSet.CalcProposer(proposal.Coinbase(), 0)
+	return valSet
+}
+
+// checkMsgToNextProposer checks that the local node is the proposer of the child
+// of the current proposal, which collects its generic votes.
+func (c *Core) checkMsgToNextProposer() error {
+	if valSet := c.current.NextValSet(); valSet == nil || !valSet.IsProposer(c.Address()) {
+		return errNotToProposer
+	}
+	return nil
+}
+
+// catchUpHeight certifies the proposal the local replica last voted for when the
+// PREPARE of the next height carries its QC. Only the proposer of that height
+// gathers the generic votes, so the other replicas learn the QC of their
+// proposal from its child. It fails if the proposal can't be certified.
+func (c *Core) catchUpHeight(msg *Message) error {
+	if !c.config.Chained || msg.Code != MsgTypePrepare || c.current.State().Cmp(StateDecided) >= 0 {
+		return nil
+	}
+	next := new(big.Int).Add(c.current.Height(), common.Big1)
+	if msg.View.Height.Cmp(next) != 0 {
+		return nil
+	}
+	vote := c.lastVote
+	if vote == nil || vote.Code != MsgTypeGenericVote || vote.Proposal == nil || vote.View.Height.Cmp(c.current.Height()) != 0 {
+		return nil
+	}
+	var prepare *MsgPrepare
+	if err := msg.Decode(&prepare); err != nil || prepare.HighQC == nil {
+		return nil
+	}
+	qc := prepare.HighQC
+	if qc.Hash != vote.Digest || qc.View == nil || qc.View.Cmp(vote.View) != 0 {
+		return nil
+	}
+	if err := c.verifyQC(qc, MsgTypeGenericVote, c.valSet); err != nil {
+		c.newLogger().Warn("Invalid parent qc in prepare", "err", err)
+		return nil
+	}
+	c.newLogger().Debug("Catch up height", "number", vote.Proposal.Number(), "hash", qc.Hash)
+	return c.certify(vote.Proposal, qc)
+}
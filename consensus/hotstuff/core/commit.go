@@ -0,0 +1,24 @@
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+)
+
+// handleCommit locks the replica on the pre-commit QC of the current proposal
+// and votes commit.
+func (c *Core) handleCommit(msg *Message, src interfaces.Validator) error {
+	logger := c.newLogger().New("from", src.Address())
+
+	qc, err := c.checkPhaseQC(msg, src, StatePreCommitted, MsgTypePreCommitVote)
+	if err != nil {
+		return err
+	}
+
+	logger.Trace("Accept commit", "qc", qc)
+
+	c.current.SetLockedQC(qc)
+	c.setState(StateCommitted)
+	c.sendVote(MsgTypeCommitVote, qc.Hash)
+	c.processBacklog()
+	return nil
+}
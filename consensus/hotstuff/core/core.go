@@ -1,12 +1,356 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
 package core
 
 import (
-	"github.com/ethereum/go-ethereum/log"
+	"math/big"
 	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/prque"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
 )
 
+// maxTimeoutExponent bounds the exponential back off of the round timeout
+const maxTimeoutExponent = 10
+
+// Core drives the basic three-phase hotstuff protocol: for every view the
+// proposer collects 2f+1 PREPARE, PRECOMMIT and COMMIT votes in turn and the
+// resulting commit QC is sealed into the block at DECIDE. In the chained mode
+// the phases are pipelined: every proposal carries the QC of its parent, and a
+// single vote per view certifies a block while it advances its ancestors.
 type Core struct {
-	logger log.Logger
+	config  *config.Config
+	logger  log.Logger
+	backend interfaces.Backend
+	signer  *Signer
+	chain   consensus.ChainReader
+	db      ethdb.Database
+
+	lastVote  *walEntry         // last vote persisted to the WAL
+	certified []*certifiedBlock // certified blocks not committed yet, in the chained mode
+
+	valSet    interfaces.ValidatorSet
+	current   *roundState
+	currentMu sync.RWMutex // Protects the current round against external readers
+
+	backlogs   map[common.Address]*prque.Prque
+	backlogsMu sync.Mutex
+
+	signedMsgs map[signedMsgKey]*signedMsg // recent signed messages, to detect equivocations
+
+	roundChangeTimer *time.Timer
+
+	events            *event.TypeMuxSubscription
+	finalCommittedSub *event.TypeMuxSubscription
+	handlerWg         sync.WaitGroup // Tracks the event loop, so stopping waits for the message in process
+
+	isRunning bool
+	runningMu sync.RWMutex
+}
+
+// New creates a hotstuff consensus core, keeping its write-ahead log in db.
+func New(backend interfaces.Backend, config *config.Config, signer *Signer, db ethdb.Database) *Core {
+	return &Core{
+		config:     config,
+		logger:     log.New("address", backend.Address()),
+		backend:    backend,
+		signer:     signer,
+		db:         db,
+		backlogs:   make(map[common.Address]*prque.Prque),
+		signedMsgs: make(map[signedMsgKey]*signedMsg),
+	}
 }
 
-var once sync.Once
+// Start implements interfaces.HotstuffCore.Start
+func (c *Core) Start(chain consensus.ChainReader) error {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	if c.isRunning {
+		return ErrStartedEngine
+	}
+	c.chain = chain
+
+	// Resume the votes and locks of the last run, then start a new round from
+	// last height + 1
+	c.lastVote = c.readWAL()
+	c.startNewRound(common.Big0)
+
+	// Tests will handle events itself, so we have to make subscribeEvents()
+	// be able to call in test.
+	c.subscribeEvents()
+	c.handlerWg.Add(1)
+	go c.handleEvents()
+
+	c.isRunning = true
+	return nil
+}
+
+// Stop implements interfaces.HotstuffCore.Stop
+func (c *Core) Stop() error {
+	c.runningMu.Lock()
+	defer c.runningMu.Unlock()
+
+	if !c.isRunning {
+		return ErrStoppedEngine
+	}
+	c.unsubscribeEvents()
+	c.handlerWg.Wait()
+	c.stopTimer()
+
+	c.currentMu.Lock()
+	c.current = nil
+	c.currentMu.Unlock()
+
+	c.isRunning = false
+	return nil
+}
+
+// IsProposer implements interfaces.HotstuffCore.IsProposer
+func (c *Core) IsProposer() bool {
+	c.currentMu.RLock()
+	defer c.currentMu.RUnlock()
+
+	if c.valSet == nil {
+		return false
+	}
+	return c.valSet.IsProposer(c.Address())
+}
+
+// IsCurrentProposal implements interfaces.HotstuffCore.IsCurrentProposal
+func (c *Core) IsCurrentProposal(blockHash common.Hash) bool {
+	c.currentMu.RLock()
+	defer c.currentMu.RUnlock()
+
+	if c.current == nil {
+		return false
+	}
+	if proposal := c.current.Proposal(); proposal != nil && proposal.Hash() == blockHash {
+		return true
+	}
+	if req := c.current.PendingRequest(); req != nil && req.Proposal.Hash() == blockHash {
+		return true
+	}
+	return false
+}
+
+// CurrentView implements interfaces.HotstuffCore.CurrentView
+func (c *Core) CurrentView() (*big.Int, *big.Int, string, bool) {
+	c.currentMu.RLock()
+	defer c.currentMu.RUnlock()
+
+	if c.current == nil {
+		return nil, nil, "", false
+	}
+	view := c.current.View()
+	return view.Height, view.Round, c.current.State().String(), true
+}
+
+func (c *Core) Address() common.Address {
+	return c.signer.EthSigner.Address()
+}
+
+// startNewRound enters the given round of the height right after the last
+// committed block. It is a no-op if the local view is already beyond it.
+func (c *Core) startNewRound(round *big.Int) {
+	logger := c.logger.New()
+
+	lastProposal, lastProposer := c.backend.LastProposal()
+	height := new(big.Int).Add(lastProposal.Number(), common.Big1)
+
+	var (
+		prepareQC, lockedQC *QuorumCert
+		prepared            *types.Block
+		pendingRequest      *interfaces.Request
+		restored            *walEntry
+	)
+	if c.current == nil {
+		// A restarted replica resumes the view it last voted in
+		if entry := c.lastVote; entry != nil && entry.View.Height.Cmp(height) == 0 && entry.View.Round.Cmp(round) >= 0 {
+			round, restored = entry.View.Round, entry
+			prepareQC, lockedQC, prepared = entry.PrepareQC, entry.LockedQC, entry.Prepared
+			logger.Debug("Restore round from WAL", "view", entry.View, "vote", entry.Code)
+		} else if entry != nil && c.config.Chained {
+			// The chained lock spans the heights, it survives a restart in any of them
+			prepareQC, lockedQC = entry.PrepareQC, entry.LockedQC
+			logger.Debug("Restore lock from WAL", "view", entry.View, "locked", lockedQC)
+		} else {
+			logger.Trace("Start to the initial round")
+		}
+	} else if height.Cmp(c.current.Height()) > 0 {
+		// The chained high QC and lock are carried over to the next proposals
+		if c.config.Chained {
+			prepareQC, lockedQC = c.current.PrepareQC(), c.current.LockedQC()
+		}
+		logger.Trace("Catch up latest proposal", "number", lastProposal.Number().Uint64(), "hash", lastProposal.Hash())
+	} else if height.Cmp(c.current.Height()) == 0 && round.Cmp(c.current.Round()) > 0 {
+		// QCs and requests of the height survive the round change
+		prepareQC, lockedQC, prepared = c.current.PrepareQC(), c.current.LockedQC(), c.current.Prepared()
+		pendingRequest = c.current.PendingRequest()
+		roundChangeCounter.Inc(1)
+		logger.Debug("Round change", "height", height, "from", c.current.Round(), "to", round)
+	} else {
+		logger.Trace("Ignore stale round", "height", height, "round", round, "current", c.current.View())
+		return
+	}
+
+	newView := &View{
+		Height: height,
+		Round:  new(big.Int).Set(round),
+	}
+	valSet := c.backend.Validators(height.Uint64())
+	valSet.CalcProposer(lastProposer, newView.Round.Uint64())
+	c.currentMu.Lock()
+	c.valSet = valSet
+	c.current = newRoundState(newView, valSet, prepareQC, lockedQC, prepared)
+	c.current.SetPendingRequest(pendingRequest)
+	c.currentMu.Unlock()
+	c.pruneSignedMsgs(height)
+	if restored != nil {
+		c.current.SetProposal(restored.Proposal)
+		c.current.SetState(restored.State)
+	}
+	c.newRoundChangeTimer()
+
+	if c.IsProposer() {
+		proposerSelfCounter.Inc(1)
+	} else {
+		proposerOthersCounter.Inc(1)
+	}
+	logger.Debug("New round", "view", newView, "proposer", c.valSet.GetProposer(), "size", c.valSet.Size(), "isProposer", c.IsProposer())
+
+	c.processBacklog()
+}
+
+// newRoundChangeTimer starts the pacemaker timer of the current view. The timeout
+// doubles with every consecutive failed round of the height.
+func (c *Core) newRoundChangeTimer() {
+	c.stopTimer()
+
+	timeout := time.Duration(c.config.RequestTimeout) * time.Millisecond
+	round := c.current.Round().Uint64()
+	if round > maxTimeoutExponent {
+		round = maxTimeoutExponent
+	}
+	timeout <<= round
+
+	view := c.current.View()
+	c.roundChangeTimer = time.AfterFunc(timeout, func() {
+		c.sendEvent(timeoutEvent{view: view})
+	})
+}
+
+func (c *Core) stopTimer() {
+	if c.roundChangeTimer != nil {
+		c.roundChangeTimer.Stop()
+	}
+}
+
+// checkView checks whether the message view is the current one, returning
+// errFutureMessage or errOldMessage otherwise.
+func (c *Core) checkView(code MsgType, view *View) error {
+	if view == nil || view.Height == nil || view.Round == nil {
+		return errInvalidMessage
+	}
+	if c.current == nil {
+		return errFutureMessage
+	}
+	if res := view.Cmp(c.current.View()); res > 0 {
+		return errFutureMessage
+	} else if res < 0 {
+		return errOldMessage
+	}
+	return nil
+}
+
+// finalizeMessage fills the sender fields of msg and signs it.
+func (c *Core) finalizeMessage(msg *Message) ([]byte, error) {
+	msg.Address = c.Address()
+	if msg.View == nil {
+		msg.View = c.current.View()
+	}
+
+	data, err := msg.PayloadNoSig()
+	if err != nil {
+		return nil, err
+	}
+	if msg.Signature, err = c.signer.EthSigner.Sign(data); err != nil {
+		return nil, err
+	}
+	return msg.Payload()
+}
+
+// broadcast sends the message to all validators, self included.
+func (c *Core) broadcast(msg *Message) {
+	logger := c.newLogger().New("msg", msg.Code)
+
+	payload, err := c.finalizeMessage(msg)
+	if err != nil {
+		logger.Error("Failed to finalize message", "msg", msg, "err", err)
+		return
+	}
+	if err := c.backend.Broadcast(c.valSet, payload); err != nil {
+		logger.Error("Failed to broadcast message", "msg", msg, "err", err)
+	}
+}
+
+// unicast sends the message to the proposer elected in the given validator set.
+func (c *Core) unicast(valSet interfaces.ValidatorSet, msg *Message) {
+	logger := c.newLogger().New("msg", msg.Code)
+
+	payload, err := c.finalizeMessage(msg)
+	if err != nil {
+		logger.Error("Failed to finalize message", "msg", msg, "err", err)
+		return
+	}
+	if err := c.backend.Unicast(valSet, payload); err != nil {
+		logger.Error("Failed to unicast message", "msg", msg, "err", err)
+	}
+}
+
+// checkMsgFromProposer checks that the message is sent by the proposer of the current view.
+func (c *Core) checkMsgFromProposer(src interfaces.Validator) error {
+	if !c.valSet.IsProposer(src.Address()) {
+		return errNotFromProposer
+	}
+	return nil
+}
+
+// checkMsgToProposer checks that the local node is the proposer of the current view.
+func (c *Core) checkMsgToProposer() error {
+	if !c.IsProposer() {
+		return errNotToProposer
+	}
+	return nil
+}
+
+func (c *Core) newLogger() log.Logger {
+	if c.current == nil {
+		return c.logger
+	}
+	return c.logger.New("state", c.current.State(), "view", c.current.View())
+}
@@ -0,0 +1,625 @@
+package core
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	event2 "github.com/ethereum/go-ethereum/consensus/hotstuff/event"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rlp"
+	blst "github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+)
+
+// testSystem wires a set of cores together through in-memory backends
+type testSystem struct {
+	backends []*testBackend
+	addrs    []common.Address
+	pubKeys  map[common.Address][]byte
+	genesis  *types.Block
+}
+
+type testBackend struct {
+	sys     *testSystem
+	key     *ecdsa.PrivateKey
+	address common.Address
+	mux     *event.TypeMux
+	core    *Core
+
+	mu        sync.Mutex
+	head      *types.Block
+	certified []*types.Block
+	committed []*types.Block
+	evidence  []*types.HotstuffEvidence
+}
+
+func newTestSystem(t *testing.T, n int, conf *config.Config) *testSystem {
+	sys := &testSystem{
+		pubKeys: make(map[common.Address][]byte),
+		genesis: types.NewBlockWithHeader(&types.Header{Number: common.Big0}),
+	}
+	db := rawdb.NewMemoryDatabase()
+
+	signers := make(map[common.Address]*Signer)
+	for i := 0; i < n; i++ {
+		key, _ := crypto.GenerateKey()
+		sk, err := blst.RandKey()
+		if err != nil {
+			t.Fatalf("failed to generate consensus key: %v", err)
+		}
+		backend := &testBackend{
+			sys:     sys,
+			key:     key,
+			address: crypto.PubkeyToAddress(key.PublicKey),
+			mux:     new(event.TypeMux),
+			head:    sys.genesis,
+		}
+		signers[backend.address] = &Signer{
+			EthSigner: NewEthSigner(key, db),
+			BlsSigner: NewBlsSigner(&sk, db),
+		}
+		sys.backends = append(sys.backends, backend)
+		sys.addrs = append(sys.addrs, backend.address)
+		sys.pubKeys[backend.address] = sk.PublicKey().Marshal()
+	}
+	for _, backend := range sys.backends {
+		backend.core = New(backend, conf, signers[backend.address], db)
+	}
+	return sys
+}
+
+func (sys *testSystem) start(t *testing.T) {
+	for _, backend := range sys.backends {
+		if err := backend.core.Start(nil); err != nil {
+			t.Fatalf("failed to start core: %v", err)
+		}
+	}
+}
+
+func (sys *testSystem) stop() {
+	for _, backend := range sys.backends {
+		backend.core.Stop()
+	}
+}
+
+// request posts a block of the given height sealed by every backend to itself,
+// like the miners of all validators do.
+func (sys *testSystem) request(height int64) {
+	for _, backend := range sys.backends {
+		block := types.NewBlockWithHeader(&types.Header{
+			Number:     big.NewInt(height),
+			ParentHash: backend.head.Hash(),
+			Coinbase:   backend.address,
+		})
+		backend.mux.Post(event2.RequestEvent{Proposal: block})
+	}
+}
+
+func (sys *testSystem) proposer() *testBackend {
+	for _, backend := range sys.backends {
+		if backend.core.IsProposer() {
+			return backend
+		}
+	}
+	return nil
+}
+
+func (b *testBackend) Address() common.Address          { return b.address }
+func (b *testBackend) EventMux() *event.TypeMux         { return b.mux }
+func (b *testBackend) HasBadProposal(common.Hash) bool  { return false }
+func (b *testBackend) ValidateBlock(*types.Block) error { return nil }
+func (b *testBackend) Close() error                     { return nil }
+
+func (b *testBackend) Broadcast(valSet interfaces.ValidatorSet, payload []byte) error {
+	for _, backend := range b.sys.backends {
+		go backend.mux.Post(event2.MessageEvent{Payload: payload})
+	}
+	return nil
+}
+
+func (b *testBackend) Gossip(valSet interfaces.ValidatorSet, payload []byte) error {
+	for _, backend := range b.sys.backends {
+		if backend != b {
+			go backend.mux.Post(event2.MessageEvent{Payload: payload})
+		}
+	}
+	return nil
+}
+
+func (b *testBackend) Unicast(valSet interfaces.ValidatorSet, payload []byte) error {
+	for _, backend := range b.sys.backends {
+		if valSet.IsProposer(backend.address) {
+			go backend.mux.Post(event2.MessageEvent{Payload: payload})
+		}
+	}
+	return nil
+}
+
+func (b *testBackend) SealProposal(proposal interfaces.Proposal, round uint64) (interfaces.Proposal, error) {
+	return proposal, nil
+}
+
+func (b *testBackend) PreCommit(proposal interfaces.Proposal, round uint64, participants []common.Address, aggregatedSeal []byte) (interfaces.Proposal, error) {
+	return proposal, nil
+}
+
+func (b *testBackend) ForwardCommit(proposal interfaces.Proposal, extra []byte) (interfaces.Proposal, error) {
+	return proposal, nil
+}
+
+func (b *testBackend) Certify(proposal interfaces.Proposal) error {
+	block := proposal.(*types.Block)
+	b.mu.Lock()
+	b.head = block
+	b.certified = append(b.certified, block)
+	b.mu.Unlock()
+
+	go b.mux.Post(event2.FinalCommittedEvent{Header: block.Header()})
+	return nil
+}
+
+// Commit extends the chain with the block in the basic mode, while the chained
+// blocks are already there since they were certified.
+func (b *testBackend) Commit(proposal interfaces.Proposal) error {
+	block := proposal.(*types.Block)
+	b.mu.Lock()
+	b.committed = append(b.committed, block)
+	if b.core.config.Chained {
+		b.mu.Unlock()
+		return nil
+	}
+	b.head = block
+	b.mu.Unlock()
+
+	go b.mux.Post(event2.FinalCommittedEvent{Header: block.Header()})
+	return nil
+}
+
+func (b *testBackend) Verify(interfaces.Proposal) (time.Duration, error) { return 0, nil }
+
+func (b *testBackend) VerifyUnsealedProposal(interfaces.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+func (b *testBackend) LastProposal() (interfaces.Proposal, common.Address) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.head, b.head.Coinbase()
+}
+
+func (b *testBackend) AddEvidence(evidence *types.HotstuffEvidence) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.evidence = append(b.evidence, evidence)
+}
+
+func (b *testBackend) Validators(number uint64) interfaces.ValidatorSet {
+	return validator.NewSetWithPublicKeys(b.sys.addrs, b.sys.pubKeys, interfaces.RoundRobin)
+}
+
+func (b *testBackend) NextValidators(proposal interfaces.Proposal) interfaces.ValidatorSet {
+	return validator.NewSetWithPublicKeys(b.sys.addrs, b.sys.pubKeys, interfaces.RoundRobin)
+}
+
+func (b *testBackend) certifiedBlocks() []*types.Block {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*types.Block{}, b.certified...)
+}
+
+func (b *testBackend) committedBlocks() []*types.Block {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]*types.Block{}, b.committed...)
+}
+
+func TestCoreCommit(t *testing.T) {
+	for _, n := range []int{1, 4} {
+		sys := newTestSystem(t, n, config.DefaultBasicConfig)
+		sys.start(t)
+
+		for height := int64(1); height <= 3; height++ {
+			proposer := sys.proposer()
+			if proposer == nil {
+				t.Fatalf("n=%d height=%d: no proposer", n, height)
+			}
+			block := types.NewBlockWithHeader(&types.Header{
+				Number:     big.NewInt(height),
+				ParentHash: proposer.head.Hash(),
+				Coinbase:   proposer.address,
+			})
+			proposer.mux.Post(event2.RequestEvent{Proposal: block})
+
+			deadline := time.Now().Add(5 * time.Second)
+			for _, backend := range sys.backends {
+				for len(backend.committedBlocks()) < int(height) {
+					if time.Now().After(deadline) {
+						t.Fatalf("n=%d height=%d: block not committed by %v", n, height, backend.address)
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+				if committed := backend.committedBlocks()[height-1]; committed.Hash() != block.Hash() {
+					t.Fatalf("n=%d height=%d: committed hash mismatch, have %v, want %v", n, height, committed.Hash(), block.Hash())
+				}
+			}
+			// wait until every core has entered the next height
+			for _, backend := range sys.backends {
+				for backend.core.current.Height().Int64() != height+1 {
+					if time.Now().After(deadline) {
+						t.Fatalf("n=%d height=%d: core stuck at %v", n, height, backend.core.current.View())
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+		}
+		sys.stop()
+	}
+}
+
+func TestCoreChained(t *testing.T) {
+	conf := *config.DefaultBasicConfig
+	conf.Chained = true
+
+	for _, n := range []int{1, 4} {
+		sys := newTestSystem(t, n, &conf)
+		sys.start(t)
+
+		// proposerAt waits for the proposer of the height to reach it, which it does
+		// once it has decided the parent with the votes of the other validators
+		proposerAt := func(height int64) *testBackend {
+			deadline := time.Now().Add(5 * time.Second)
+			for time.Now().Before(deadline) {
+				for _, backend := range sys.backends {
+					if backend.core.current.Height().Int64() == height && backend.core.IsProposer() {
+						return backend
+					}
+				}
+				time.Sleep(10 * time.Millisecond)
+			}
+			t.Fatalf("n=%d height=%d: no proposer", n, height)
+			return nil
+		}
+		var proposals []*types.Block
+		for height := int64(1); height <= 6; height++ {
+			proposer := proposerAt(height)
+			block := types.NewBlockWithHeader(&types.Header{
+				Number:     big.NewInt(height),
+				ParentHash: proposer.head.Hash(),
+				Coinbase:   proposer.address,
+			})
+			proposals = append(proposals, block)
+			proposer.mux.Post(event2.RequestEvent{Proposal: block})
+
+			// every validator certifies a block once the proposal of its child carries
+			// its QC, and commits the head of the resulting three-chain
+			deadline := time.Now().Add(5 * time.Second)
+			for _, backend := range sys.backends {
+				for len(backend.certifiedBlocks()) < int(height)-1 || len(backend.committedBlocks()) < int(height)-3 {
+					if time.Now().After(deadline) {
+						t.Fatalf("n=%d height=%d: ancestors not certified by %v", n, height, backend.address)
+					}
+					time.Sleep(10 * time.Millisecond)
+				}
+			}
+		}
+		// the proposer of the next height has gathered all votes of the last proposal
+		next := proposerAt(7)
+		if last := next.certifiedBlocks(); last[5].Hash() != proposals[5].Hash() {
+			t.Fatalf("n=%d: last block mismatch, have %v, want %v", n, last[5].Hash(), proposals[5].Hash())
+		}
+		if committed := next.committedBlocks(); len(committed) != 4 || committed[3].Hash() != proposals[3].Hash() {
+			t.Fatalf("n=%d: committed blocks mismatch, have %d, want %d", n, len(committed), 4)
+		}
+		for _, backend := range sys.backends {
+			for i, certified := range backend.certifiedBlocks()[:5] {
+				if certified.Hash() != proposals[i].Hash() {
+					t.Fatalf("n=%d: block %d mismatch on %v, have %v, want %v", n, i+1, backend.address, certified.Hash(), proposals[i].Hash())
+				}
+			}
+			// the blocks of the last two-chain are not final yet
+			committed := backend.committedBlocks()
+			if backend != next && len(committed) != 3 {
+				t.Fatalf("n=%d: committed blocks mismatch on %v, have %d, want %d", n, backend.address, len(committed), 3)
+			}
+			for i, block := range committed {
+				if block.Hash() != proposals[i].Hash() {
+					t.Fatalf("n=%d: committed block %d mismatch on %v, have %v, want %v", n, i+1, backend.address, block.Hash(), proposals[i].Hash())
+				}
+			}
+		}
+		sys.stop()
+	}
+}
+
+// Tests that the view of a generic QC is signed by its voters, so that a proposer
+// can't raise it past the lock of the replicas.
+func TestChainedQCView(t *testing.T) {
+	conf := *config.DefaultBasicConfig
+	conf.Chained = true
+	sys := newTestSystem(t, 4, &conf)
+	c := sys.backends[0].core
+
+	view := &View{Height: big.NewInt(1), Round: big.NewInt(0)}
+	hash := common.Hash{0x01}
+	qc := &QuorumCert{View: view, Code: MsgTypeGenericVote, Hash: hash}
+	var sigs []blscommon.Signature
+	for _, backend := range sys.backends[:3] {
+		seal, err := backend.core.signer.BlsSigner.SignVote(MsgTypeGenericVote, view, hash)
+		if err != nil {
+			t.Fatalf("failed to sign vote: %v", err)
+		}
+		sig, _ := blst.SignatureFromBytes(seal)
+		sigs = append(sigs, sig)
+		qc.Signers = append(qc.Signers, backend.address)
+	}
+	qc.Seal = blst.AggregateSignatures(sigs).Marshal()
+	valSet := sys.backends[0].Validators(1)
+	if err := c.verifyQC(qc, MsgTypeGenericVote, valSet); err != nil {
+		t.Fatalf("failed to verify qc: %v", err)
+	}
+	raised := *qc
+	raised.View = &View{Height: big.NewInt(1), Round: big.NewInt(5)}
+	if err := c.verifyQC(&raised, MsgTypeGenericVote, valSet); err == nil {
+		t.Fatalf("qc with a raised view verified")
+	}
+	other := *qc
+	other.Code = MsgTypeCommitVote
+	if err := c.verifyQC(&other, MsgTypeCommitVote, valSet); err == nil {
+		t.Fatalf("qc of another phase verified")
+	}
+}
+
+// Tests that a certified block is only committed once it heads a direct chain of
+// three blocks, certified in consecutive views, along with the blocks before it.
+func TestChainedDirectCommit(t *testing.T) {
+	conf := *config.DefaultBasicConfig
+	conf.Chained = true
+	sys := newTestSystem(t, 4, &conf)
+	backend := sys.backends[0]
+	c := backend.core
+
+	valSet := backend.Validators(1)
+	c.current = newRoundState(&View{Height: big.NewInt(1), Round: big.NewInt(0)}, valSet, nil, nil, nil)
+
+	// The second block is certified after a round change
+	var (
+		parent = sys.genesis
+		rounds = []int64{0, 1, 0, 0, 0}
+		blocks []*types.Block
+	)
+	for i := range rounds {
+		block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(int64(i + 1)), ParentHash: parent.Hash()})
+		blocks = append(blocks, block)
+		parent = block
+	}
+	certify := func(i int) {
+		qc := &QuorumCert{
+			View: &View{Height: blocks[i].Number(), Round: big.NewInt(rounds[i])},
+			Code: MsgTypeGenericVote,
+			Hash: unsealedHash(blocks[i]),
+		}
+		if err := c.updateQC(blocks[i], qc); err != nil {
+			t.Fatalf("failed to certify block %d: %v", i+1, err)
+		}
+	}
+	for i := 0; i < 3; i++ {
+		certify(i)
+	}
+	if committed := backend.committedBlocks(); len(committed) != 0 {
+		t.Fatalf("committed without a direct chain: have %d blocks", len(committed))
+	}
+	if lockedQC := c.current.LockedQC(); lockedQC == nil || lockedQC.Hash != unsealedHash(blocks[1]) {
+		t.Fatalf("locked qc mismatch: have %v, want block 2", lockedQC)
+	}
+	// The direct chain of blocks 2, 3 and 4 commits block 2 and its ancestor
+	certify(3)
+	if committed := backend.committedBlocks(); len(committed) != 2 || committed[0] != blocks[0] || committed[1] != blocks[1] {
+		t.Fatalf("committed blocks mismatch: have %d blocks, want 2", len(committed))
+	}
+	certify(4)
+	if committed := backend.committedBlocks(); len(committed) != 3 || committed[2] != blocks[2] {
+		t.Fatalf("committed blocks mismatch: have %d blocks, want 3", len(committed))
+	}
+}
+
+func TestCoreRoundChange(t *testing.T) {
+	conf := *config.DefaultBasicConfig
+	conf.RequestTimeout = 200
+
+	sys := newTestSystem(t, 4, &conf)
+	sys.start(t)
+	defer sys.stop()
+
+	// crash the first proposer, the others have to change view to commit
+	crashed := sys.proposer()
+	crashed.core.Stop()
+	live := make([]*testBackend, 0, len(sys.backends)-1)
+	for _, backend := range sys.backends {
+		if backend != crashed {
+			live = append(live, backend)
+		}
+	}
+	sys.backends = live
+	sys.request(1)
+
+	deadline := time.Now().Add(5 * time.Second)
+	for _, backend := range live {
+		for len(backend.committedBlocks()) < 1 {
+			if time.Now().After(deadline) {
+				t.Fatalf("block not committed by %v after crash of %v", backend.address, crashed.address)
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+	}
+	committed := live[0].committedBlocks()[0]
+	if committed.Coinbase() == crashed.address {
+		t.Fatalf("committed block of the crashed proposer")
+	}
+	for _, backend := range live[1:] {
+		if hash := backend.committedBlocks()[0].Hash(); hash != committed.Hash() {
+			t.Fatalf("conflicting commits: %v and %v", hash, committed.Hash())
+		}
+	}
+}
+
+func TestCoreWAL(t *testing.T) {
+	conf := *config.DefaultBasicConfig
+	conf.RequestTimeout = 60000
+
+	sys := newTestSystem(t, 4, &conf)
+	backend := sys.backends[0]
+	c := backend.core
+	if err := c.Start(nil); err != nil {
+		t.Fatalf("failed to start core: %v", err)
+	}
+	c.startNewRound(big.NewInt(2))
+
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), ParentHash: sys.genesis.Hash()})
+	lockedQC := &QuorumCert{
+		View: &View{Height: big.NewInt(1), Round: big.NewInt(1)},
+		Code: MsgTypePreCommitVote,
+		Hash: block.Hash(),
+	}
+	c.current.SetProposal(block)
+	c.current.SetLockedQC(lockedQC)
+	c.current.SetState(StatePrepared)
+	c.sendVote(MsgTypePrepareVote, block.Hash())
+
+	// A restarted core resumes the voted view with its lock
+	c.Stop()
+	if err := c.Start(nil); err != nil {
+		t.Fatalf("failed to restart core: %v", err)
+	}
+	defer c.Stop()
+
+	if view := c.current.View(); view.Height.Int64() != 1 || view.Round.Int64() != 2 {
+		t.Fatalf("restored view mismatch: have %v, want 1/2", view)
+	}
+	if state := c.current.State(); state != StatePrepared {
+		t.Fatalf("restored state mismatch: have %v, want %v", state, StatePrepared)
+	}
+	if proposal := c.current.Proposal(); proposal == nil || proposal.Hash() != block.Hash() {
+		t.Fatalf("restored proposal mismatch")
+	}
+	if qc := c.current.LockedQC(); qc == nil || qc.Hash != lockedQC.Hash || qc.View.Cmp(lockedQC.View) != 0 {
+		t.Fatalf("restored locked qc mismatch: have %v, want %v", qc, lockedQC)
+	}
+
+	// The same vote is never cast twice, the next phases and views still are
+	view := c.current.View()
+	if !c.lastVote.voted(MsgTypePrepareVote, view) {
+		t.Fatalf("restored vote not recorded")
+	}
+	other := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(1), Coinbase: backend.address})
+	c.sendVote(MsgTypePrepareVote, other.Hash())
+	if c.lastVote.Digest != block.Hash() {
+		t.Fatalf("double vote recorded in the WAL")
+	}
+	if c.lastVote.voted(MsgTypePreCommitVote, view) {
+		t.Fatalf("next phase reported as voted")
+	}
+	if c.lastVote.voted(MsgTypePrepareVote, &View{Height: big.NewInt(1), Round: big.NewInt(3)}) {
+		t.Fatalf("next view reported as voted")
+	}
+	if !c.lastVote.voted(MsgTypeCommitVote, &View{Height: big.NewInt(1), Round: big.NewInt(1)}) {
+		t.Fatalf("previous view reported as unvoted")
+	}
+}
+
+func TestCoreEquivocation(t *testing.T) {
+	conf := *config.DefaultBasicConfig
+	conf.RequestTimeout = 60000
+
+	sys := newTestSystem(t, 4, &conf)
+	watcher, offender := sys.backends[0], sys.backends[1]
+	if err := watcher.core.Start(nil); err != nil {
+		t.Fatalf("failed to start core: %v", err)
+	}
+	defer watcher.core.Stop()
+
+	// vote signs a prepare vote of the offender for the given digest
+	view := watcher.core.current.View()
+	vote := func(digest common.Hash) *Message {
+		payload, _ := rlp.EncodeToBytes(&Vote{Code: MsgTypePrepareVote, View: view, Digest: digest})
+		msg := &Message{
+			Code:          MsgTypePrepareVote,
+			View:          view,
+			Msg:           payload,
+			CommittedSeal: offender.core.signer.BlsSigner.Sign(VoteDigest(MsgTypePrepareVote, view, digest).Bytes()).Marshal(),
+		}
+		if _, err := offender.core.finalizeMessage(msg); err != nil {
+			t.Fatalf("failed to sign vote: %v", err)
+		}
+		return msg
+	}
+	first, second := vote(common.HexToHash("0x01")), vote(common.HexToHash("0x02"))
+	for _, msg := range []*Message{first, first, second} {
+		payload, _ := msg.Payload()
+		watcher.mux.Post(event2.MessageEvent{Payload: payload})
+	}
+
+	var (
+		evidence *types.HotstuffEvidence
+		deadline = time.Now().Add(5 * time.Second)
+	)
+	for evidence == nil {
+		watcher.mu.Lock()
+		if len(watcher.evidence) > 0 {
+			evidence = watcher.evidence[0]
+		}
+		watcher.mu.Unlock()
+		if time.Now().After(deadline) {
+			t.Fatalf("equivocation not reported")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	if evidence.Offender != offender.address {
+		t.Fatalf("offender mismatch: have %v, want %v", evidence.Offender, offender.address)
+	}
+	var (
+		signer = watcher.core.signer
+		valSet = watcher.Validators(view.Height.Uint64())
+	)
+	if err := signer.VerifyEvidence(evidence, valSet, 0, view.Height.Uint64()); err != nil {
+		t.Fatalf("valid evidence rejected: %v", err)
+	}
+	if err := signer.VerifyEvidence(evidence, valSet, 0, view.Height.Uint64()-1); err != errInvalidEvidence {
+		t.Fatalf("evidence from the future accepted: %v", err)
+	}
+	if err := signer.VerifyEvidence(evidence, valSet, view.Height.Uint64()+1, view.Height.Uint64()+1); err != errInvalidEvidence {
+		t.Fatalf("stale evidence accepted: %v", err)
+	}
+	swapped := &types.HotstuffEvidence{Offender: evidence.Offender, First: evidence.Second, Second: evidence.First}
+	if err := signer.VerifyEvidence(swapped, valSet, 0, view.Height.Uint64()); err != errInvalidEvidence {
+		t.Fatalf("unordered evidence accepted: %v", err)
+	}
+	framed := &types.HotstuffEvidence{Offender: watcher.address, First: evidence.First, Second: evidence.Second}
+	if err := signer.VerifyEvidence(framed, valSet, 0, view.Height.Uint64()); err != errInvalidEvidence {
+		t.Fatalf("evidence against another validator accepted: %v", err)
+	}
+	same, _ := newEvidence(first, first)
+	if err := signer.VerifyEvidence(same, valSet, 0, view.Height.Uint64()); err != errInvalidEvidence {
+		t.Fatalf("evidence of a single message accepted: %v", err)
+	}
+	// The seals are verified with the consensus key the validator set records
+	rotated, _ := blst.RandKey()
+	pubKeys := make(map[common.Address][]byte)
+	for addr, pubKey := range watcher.sys.pubKeys {
+		pubKeys[addr] = pubKey
+	}
+	pubKeys[offender.address] = rotated.PublicKey().Marshal()
+	rotatedSet := validator.NewSetWithPublicKeys(watcher.sys.addrs, pubKeys, interfaces.RoundRobin)
+	if err := signer.VerifyEvidence(evidence, rotatedSet, 0, view.Height.Uint64()); err != errInvalidEvidence {
+		t.Fatalf("evidence sealed with another key accepted: %v", err)
+	}
+}
@@ -0,0 +1,40 @@
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// handleDecide commits the current proposal with the commit QC of the DECIDE
+// message.
+func (c *Core) handleDecide(msg *Message, src interfaces.Validator) error {
+	qc, err := c.checkPhaseQC(msg, src, StateCommitted, MsgTypeCommitVote)
+	if err != nil {
+		return err
+	}
+	return c.decide(c.current.Proposal(), qc)
+}
+
+// decide seals the QC into the proposal it certifies and delivers the block to
+// the backend. The next round is started once the block is written to the chain
+// and reported by a FinalCommittedEvent.
+func (c *Core) decide(proposal *types.Block, qc *QuorumCert) error {
+	logger := c.newLogger()
+
+	c.current.SetCommitQC(qc)
+
+	sealed, err := c.backend.PreCommit(proposal, qc.View.Round.Uint64(), qc.Signers, qc.Seal)
+	if err != nil {
+		logger.Error("Failed to seal proposal", "hash", qc.Hash, "err", err)
+		return err
+	}
+	if err := c.backend.Commit(sealed); err != nil {
+		logger.Error("Failed to commit proposal", "hash", qc.Hash, "err", err)
+		return err
+	}
+
+	c.current.SetDecided(sealed.Hash())
+	c.setState(StateDecided)
+	logger.Debug("Committed", "number", sealed.Number(), "hash", sealed.Hash(), "signers", len(qc.Signers))
+	return nil
+}
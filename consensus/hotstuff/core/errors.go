@@ -45,4 +45,33 @@ var (
 	errBADProposal = errors.New("bad proposal")
 	// errValidatorNotFound
 	errValidatorNotFound = errors.New("validator not found with index")
+	// errFutureMessage is returned when current view is earlier than the
+	// view of the received message.
+	errFutureMessage = errors.New("future message")
+	// errOldMessage is returned when the received message's view is earlier
+	// than current view.
+	errOldMessage = errors.New("old message")
+	// errInvalidMessage is returned when the message is malformed.
+	errInvalidMessage = errors.New("invalid message")
+	// errNotFromProposer is returned when received message is supposed to be from
+	// proposer.
+	errNotFromProposer = errors.New("message does not come from proposer")
+	// errNotToProposer is returned when received message is supposed to be to
+	// proposer.
+	errNotToProposer = errors.New("message does not address to proposer")
+	// errAlreadyVoted is returned when the replica has already voted in the phase
+	// of the received message.
+	errAlreadyVoted = errors.New("already voted")
+	// errUnsafeProposal is returned when the proposal conflicts with the locked qc.
+	errUnsafeProposal = errors.New("proposal conflicts with locked qc")
+	// errInvalidDigest is returned when the message refers to another proposal
+	// than the current one.
+	errInvalidDigest = errors.New("invalid proposal digest")
+	// errInvalidQC is returned when the quorum certificate is malformed.
+	errInvalidQC = errors.New("invalid quorum certificate")
+	// errInsufficientQC is returned when the quorum certificate has less than 2f+1 signers.
+	errInsufficientQC = errors.New("insufficient quorum certificate signers")
+	// errInvalidEvidence is returned when an evidence does not prove that its
+	// offender signed two conflicting messages for the same view.
+	errInvalidEvidence = errors.New("invalid equivocation evidence")
 )
@@ -8,18 +8,15 @@ import (
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
 	"golang.org/x/crypto/sha3"
-	"strconv"
 )
 
 type EthSigner struct {
-	address     common.Address
-	privateKey  *ecdsa.PrivateKey
-	db          ethdb.Database
-	ValidatorNo int
+	address    common.Address
+	privateKey *ecdsa.PrivateKey
+	remote     RemoteSigner // Signer holding the leader key instead of privateKey, if any
+	db         ethdb.Database
 }
 
-var ValidatorAddressPrefix = "bls-public-key"
-
 func NewEthSigner(privateKey *ecdsa.PrivateKey, db ethdb.Database) *EthSigner {
 	return &EthSigner{
 		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
@@ -28,6 +25,15 @@ func NewEthSigner(privateKey *ecdsa.PrivateKey, db ethdb.Database) *EthSigner {
 	}
 }
 
+// NewRemoteEthSigner creates a signer leaving the leader key to the remote signer.
+func NewRemoteEthSigner(remote RemoteSigner, db ethdb.Database) *EthSigner {
+	return &EthSigner{
+		address: remote.Address(),
+		remote:  remote,
+		db:      db,
+	}
+}
+
 func (ethSigner *EthSigner) Address() common.Address {
 	return ethSigner.address
 }
@@ -39,7 +45,12 @@ func (ethSigner *EthSigner) Address() common.Address {
 // Note, the method requires the extra data to be at least 65 bytes, otherwise it
 // panics. This is done to avoid accidentally using both forms (signature present
 // or not), which could be abused to produce different hashes for the same header.
-func (ethSigner *EthSigner) SigHash(header *types.Header) (hash common.Hash) {
+func (ethSigner *EthSigner) SigHash(header *types.Header) common.Hash {
+	return SigHash(header)
+}
+
+// SigHash returns the hash of the header the proposer signs, see EthSigner.SigHash.
+func SigHash(header *types.Header) (hash common.Hash) {
 	hasher := sha3.NewLegacyKeccak256()
 
 	// Clean seal is required for calculating proposer seal.
@@ -48,7 +59,12 @@ func (ethSigner *EthSigner) SigHash(header *types.Header) (hash common.Hash) {
 	return hash
 }
 
+// Sign signs the keccak256 hash of the data, which is the rlp encoding of a
+// consensus message without signature when signing remotely.
 func (ethSigner *EthSigner) Sign(data []byte) ([]byte, error) {
+	if ethSigner.remote != nil {
+		return ethSigner.remote.SignMessage(data)
+	}
 	hashData := crypto.Keccak256(data)
 	return crypto.Sign(hashData, ethSigner.privateKey)
 }
@@ -91,9 +107,16 @@ func (ethSigner *EthSigner) VerifyLeaderSeal(header *types.Header) error {
 
 // SignerSeal proposer sign the header hash and fill extra seal with signature.
 func (ethSigner *EthSigner) SealBeforeCommit(h *types.Header) error {
-	sigHash := ethSigner.SigHash(h)
-	seal, err := ethSigner.Sign(sigHash.Bytes())
-	if err != nil {
+	var (
+		seal []byte
+		err  error
+	)
+	if ethSigner.remote != nil {
+		// the remote signer tells why it refused to seal
+		if seal, err = ethSigner.remote.SignSeal(h); err != nil {
+			return err
+		}
+	} else if seal, err = ethSigner.Sign(ethSigner.SigHash(h).Bytes()); err != nil {
 		return errInvalidSignature
 	}
 
@@ -114,15 +137,6 @@ func (ethSigner *EthSigner) SealBeforeCommit(h *types.Header) error {
 	return nil
 }
 
-func (ethSigner *EthSigner) StoreValidator(val common.Address, valIndex int) {
-	ethSigner.db.Put(append([]byte(ValidatorAddressPrefix), []byte(strconv.Itoa(valIndex))...), val.Bytes())
-}
-
-func (ethSigner *EthSigner) GetValidator(valIndex int) (common.Address, error) {
-	val, err := ethSigner.db.Get(append([]byte(ValidatorAddressPrefix), []byte(strconv.Itoa(valIndex))...))
-	return common.BytesToAddress(val), err
-}
-
 // GetSignatureAddress gets the address address from the signature
 func getSignatureAddress(data []byte, sig []byte) (common.Address, error) {
 	// 1. Keccak data
@@ -0,0 +1,215 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"bytes"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// signedMsgHeights is the number of heights below the current one whose signed
+// messages are kept to detect equivocations.
+const signedMsgHeights = 1
+
+// signedMsgKey identifies the single message a validator may sign for a phase
+// of a view.
+type signedMsgKey struct {
+	height  uint64
+	round   uint64
+	code    MsgType
+	address common.Address
+}
+
+// signedMsg is a message kept to detect equivocations, along with the proposal
+// it commits its sender to.
+type signedMsg struct {
+	msg    *Message
+	digest common.Hash
+}
+
+// EquivocationDigest returns the proposal a PREPARE message or a vote commits its
+// sender to. Two messages of the same phase and view committing to different
+// proposals are an equivocation. ok is false for the other message types.
+func EquivocationDigest(msg *Message) (digest common.Hash, ok bool, err error) {
+	switch msg.Code {
+	case MsgTypePrepare:
+		var prepare *MsgPrepare
+		if err := msg.Decode(&prepare); err != nil || prepare.Proposal == nil {
+			return common.Hash{}, true, errDecodeFailed
+		}
+		return prepare.Proposal.Hash(), true, nil
+
+	case MsgTypePrepareVote, MsgTypePreCommitVote, MsgTypeCommitVote, MsgTypeGenericVote:
+		var vote *Vote
+		if err := msg.Decode(&vote); err != nil {
+			return common.Hash{}, true, errDecodeFailed
+		}
+		if vote.Code != msg.Code || vote.View == nil || msg.View == nil || vote.View.Cmp(msg.View) != 0 {
+			return common.Hash{}, true, errInvalidMessage
+		}
+		return vote.Digest, true, nil
+	}
+	return common.Hash{}, false, nil
+}
+
+// checkEquivocation keeps the signed PREPARE messages and votes of the recent
+// views, and broadcasts an evidence when the sender of msg already signed a
+// conflicting one.
+func (c *Core) checkEquivocation(msg *Message) {
+	if c.current == nil || msg.View == nil || msg.View.Height == nil || msg.View.Round == nil {
+		return
+	}
+	digest, ok, err := EquivocationDigest(msg)
+	if !ok || err != nil {
+		return
+	}
+	height := c.current.Height()
+	if new(big.Int).Add(msg.View.Height, big.NewInt(signedMsgHeights)).Cmp(height) < 0 || msg.View.Height.Cmp(new(big.Int).Add(height, common.Big1)) > 0 {
+		return
+	}
+	key := signedMsgKey{
+		height:  msg.View.Height.Uint64(),
+		round:   msg.View.Round.Uint64(),
+		code:    msg.Code,
+		address: msg.Address,
+	}
+	prev, ok := c.signedMsgs[key]
+	if !ok {
+		c.signedMsgs[key] = &signedMsg{msg: msg, digest: digest}
+		return
+	}
+	if prev.digest == digest {
+		return
+	}
+	evidence, err := newEvidence(prev.msg, msg)
+	if err != nil {
+		c.logger.Error("Failed to assemble evidence", "offender", msg.Address, "err", err)
+		return
+	}
+	payload, err := rlp.EncodeToBytes(evidence)
+	if err != nil {
+		c.logger.Error("Failed to encode evidence", "offender", msg.Address, "err", err)
+		return
+	}
+	c.newLogger().Warn("Equivocation detected", "offender", msg.Address, "code", msg.Code, "view", msg.View, "first", prev.digest, "second", digest)
+	c.broadcast(&Message{
+		Code: MsgTypeEvidence,
+		Msg:  payload,
+	})
+}
+
+// pruneSignedMsgs drops the signed messages kept for the heights too far below
+// the given one.
+func (c *Core) pruneSignedMsgs(height *big.Int) {
+	for key := range c.signedMsgs {
+		if key.height+signedMsgHeights < height.Uint64() {
+			delete(c.signedMsgs, key)
+		}
+	}
+}
+
+// handleEvidence hands the equivocation evidence gossiped by a validator to the
+// backend, once verified.
+func (c *Core) handleEvidence(msg *Message, src interfaces.Validator) error {
+	logger := c.newLogger().New("from", src.Address())
+
+	var evidence *types.HotstuffEvidence
+	if err := msg.Decode(&evidence); err != nil {
+		return errDecodeFailed
+	}
+	// The engine bounds the evidence to the epoch of the block including it
+	if err := c.signer.VerifyEvidence(evidence, c.valSet, 0, c.current.Height().Uint64()); err != nil {
+		logger.Warn("Invalid evidence", "offender", evidence.Offender, "err", err)
+		return err
+	}
+	logger.Debug("Accept evidence", "offender", evidence.Offender, "hash", evidence.Hash())
+	c.backend.AddEvidence(evidence)
+	return nil
+}
+
+// newEvidence assembles the evidence of the two conflicting messages.
+func newEvidence(first, second *Message) (*types.HotstuffEvidence, error) {
+	a, err := first.Payload()
+	if err != nil {
+		return nil, err
+	}
+	b, err := second.Payload()
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Compare(a, b) > 0 {
+		a, b = b, a
+	}
+	return &types.HotstuffEvidence{
+		Offender: first.Address,
+		First:    a,
+		Second:   b,
+	}, nil
+}
+
+// VerifyEvidence checks that the evidence proves its offender, a validator of
+// the set, signed at a height within [first, last] two messages of the same
+// phase and view committing to different proposals. Both messages must carry
+// the ECDSA signature of the offender, and votes its BLS seal too.
+func (s *Signer) VerifyEvidence(evidence *types.HotstuffEvidence, valSet interfaces.ValidatorSet, first, last uint64) error {
+	if evidence == nil || bytes.Compare(evidence.First, evidence.Second) >= 0 {
+		return errInvalidEvidence
+	}
+	msgs := []*Message{new(Message), new(Message)}
+	if err := rlp.DecodeBytes(evidence.First, msgs[0]); err != nil {
+		return errInvalidEvidence
+	}
+	if err := rlp.DecodeBytes(evidence.Second, msgs[1]); err != nil {
+		return errInvalidEvidence
+	}
+	if msgs[0].View == nil || msgs[1].View == nil || msgs[0].Code != msgs[1].Code || msgs[0].View.Cmp(msgs[1].View) != 0 {
+		return errInvalidEvidence
+	}
+	if height := msgs[0].View.Height; height.Cmp(new(big.Int).SetUint64(first)) < 0 || height.Cmp(new(big.Int).SetUint64(last)) > 0 {
+		return errInvalidEvidence
+	}
+	var digests [2]common.Hash
+	for i, msg := range msgs {
+		if msg.Address != evidence.Offender {
+			return errInvalidEvidence
+		}
+		if err := msg.VerifySignature(); err != nil {
+			return errInvalidEvidence
+		}
+		digest, ok, err := EquivocationDigest(msg)
+		if !ok || err != nil {
+			return errInvalidEvidence
+		}
+		if msg.Code != MsgTypePrepare {
+			if err := s.BlsSigner.VerifySignature(valSet, msg.Address, msg.CommittedSeal, VoteDigest(msg.Code, msg.View, digest)); err != nil {
+				return errInvalidEvidence
+			}
+		}
+		digests[i] = digest
+	}
+	if digests[0] == digests[1] {
+		return errInvalidEvidence
+	}
+	return nil
+}
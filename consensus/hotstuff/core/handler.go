@@ -0,0 +1,216 @@
+package core
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	event2 "github.com/ethereum/go-ethereum/consensus/hotstuff/event"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// backlogEvent re-posts a stored future message once its view is reached
+type backlogEvent struct {
+	msg *Message
+}
+
+// timeoutEvent is posted when the round timer of the view expires
+type timeoutEvent struct {
+	view *View
+}
+
+// Subscribe both internal and external events
+func (c *Core) subscribeEvents() {
+	c.events = c.backend.EventMux().Subscribe(
+		// external events
+		event2.RequestEvent{},
+		event2.MessageEvent{},
+		// internal events
+		backlogEvent{},
+		timeoutEvent{},
+	)
+	c.finalCommittedSub = c.backend.EventMux().Subscribe(
+		event2.FinalCommittedEvent{},
+	)
+}
+
+// Unsubscribe all events
+func (c *Core) unsubscribeEvents() {
+	c.events.Unsubscribe()
+	c.finalCommittedSub.Unsubscribe()
+}
+
+func (c *Core) handleEvents() {
+	defer c.handlerWg.Done()
+	logger := c.logger.New("handleEvents")
+
+	for {
+		select {
+		case ev, ok := <-c.events.Chan():
+			if !ok {
+				return
+			}
+			// A real event arrived, process interesting content
+			switch ev := ev.Data.(type) {
+			case event2.RequestEvent:
+				if err := c.handleRequest(&interfaces.Request{Proposal: ev.Proposal}); err != nil {
+					logger.Trace("Failed to handle request", "err", err)
+				}
+			case event2.MessageEvent:
+				if err := c.handleMsg(ev.Payload); err != nil {
+					logger.Trace("Failed to handle message", "err", err)
+				}
+			case backlogEvent:
+				if err := c.handleCheckedMsg(ev.msg); err != nil {
+					logger.Trace("Failed to handle backlog message", "msg", ev.msg, "err", err)
+				}
+			case timeoutEvent:
+				c.handleTimeout(ev)
+			}
+		case ev, ok := <-c.finalCommittedSub.Chan():
+			if !ok {
+				return
+			}
+			switch ev := ev.Data.(type) {
+			case event2.FinalCommittedEvent:
+				c.handleFinalCommitted(ev)
+			}
+		}
+	}
+}
+
+// sendEvent sends events to mux
+func (c *Core) sendEvent(ev interface{}) {
+	c.backend.EventMux().Post(ev)
+}
+
+func (c *Core) handleMsg(payload []byte) error {
+	logger := c.newLogger()
+
+	// Decode message and check its signature
+	msg := new(Message)
+	if err := rlp.DecodeBytes(payload, msg); err != nil {
+		logger.Error("Failed to decode message from payload", "err", err)
+		return errDecodeFailed
+	}
+	if err := msg.VerifySignature(); err != nil {
+		logger.Error("Failed to verify message signature", "msg", msg, "err", err)
+		return err
+	}
+
+	// Only accept message if the address is valid
+	if _, src := c.valSet.GetByAddress(msg.Address); src == nil {
+		logger.Error("Invalid address in message", "msg", msg)
+		return errUnauthorizedAddress
+	}
+	c.checkEquivocation(msg)
+
+	return c.handleCheckedMsg(msg)
+}
+
+func (c *Core) handleCheckedMsg(msg *Message) error {
+	if c.current == nil {
+		return ErrStoppedEngine
+	}
+	_, src := c.valSet.GetByAddress(msg.Address)
+	if src == nil {
+		return errUnauthorizedAddress
+	}
+	if err := c.checkProtocol(msg.Code); err != nil {
+		return err
+	}
+
+	// Store the message if it's a future message
+	testBacklog := func(err error) error {
+		if err == errFutureMessage {
+			if c.catchUpRound(msg, src) {
+				return c.handleCheckedMsg(msg)
+			}
+			if err := c.catchUpHeight(msg); err != nil {
+				return err
+			}
+			c.storeBacklog(msg)
+		}
+		return err
+	}
+
+	switch msg.Code {
+	case MsgTypeNewView:
+		return testBacklog(c.handleNewView(msg, src))
+	case MsgTypePrepare:
+		return testBacklog(c.handlePrepare(msg, src))
+	case MsgTypePrepareVote, MsgTypePreCommitVote, MsgTypeCommitVote, MsgTypeGenericVote:
+		return testBacklog(c.handleVote(msg, src))
+	case MsgTypePreCommit:
+		return testBacklog(c.handlePreCommit(msg, src))
+	case MsgTypeCommit:
+		return testBacklog(c.handleCommit(msg, src))
+	case MsgTypeDecide:
+		return testBacklog(c.handleDecide(msg, src))
+	case MsgTypeEvidence:
+		return c.handleEvidence(msg, src)
+	default:
+		c.newLogger().Error("msg type invalid", "msg", msg)
+	}
+	return errInvalidMessage
+}
+
+// handleFinalCommitted moves to the next height once a block at or above the
+// current height has been written to the chain.
+func (c *Core) handleFinalCommitted(ev event2.FinalCommittedEvent) {
+	if ev.Header == nil || c.current == nil {
+		return
+	}
+	if ev.Header.Number.Cmp(c.current.Height()) >= 0 {
+		c.newLogger().Trace("Received final committed header", "number", ev.Header.Number, "hash", ev.Header.Hash())
+		c.startNewRound(common.Big0)
+	}
+}
+
+// handleTimeout gives up the view whose timer expired: it moves to the next round
+// and reports the highest known QC to the proposer of that round.
+func (c *Core) handleTimeout(ev timeoutEvent) {
+	if c.current == nil || ev.view.Cmp(c.current.View()) != 0 {
+		return
+	}
+	c.newLogger().Debug("Round timeout", "proposer", c.valSet.GetProposer())
+	timeoutCounter.Inc(1)
+
+	c.startNewRound(new(big.Int).Add(ev.view.Round, common.Big1))
+	c.sendNewView()
+}
+
+// catchUpRound jumps to a higher round of the current height when its proposer
+// has already proposed in it, which resynchronizes a replica whose timer lagged
+// behind the others'.
+func (c *Core) catchUpRound(msg *Message, src interfaces.Validator) bool {
+	if msg.Code != MsgTypePrepare || msg.View.Height.Cmp(c.current.Height()) != 0 || msg.View.Round.Cmp(c.current.Round()) <= 0 {
+		return false
+	}
+	_, lastProposer := c.backend.LastProposal()
+	valSet := c.valSet.Copy()
+	valSet.CalcProposer(lastProposer, msg.View.Round.Uint64())
+	if !valSet.IsProposer(src.Address()) {
+		return false
+	}
+
+	c.newLogger().Debug("Catch up round", "round", msg.View.Round, "proposer", src.Address())
+	c.startNewRound(msg.View.Round)
+	return c.current.Round().Cmp(msg.View.Round) == 0
+}
+
+// VerifySignature recovers the sender of the message and checks it is the claimed address.
+func (msg *Message) VerifySignature() error {
+	data, err := msg.PayloadNoSig()
+	if err != nil {
+		return err
+	}
+	signer, err := getSignatureAddress(data, msg.Signature)
+	if err != nil {
+		return err
+	}
+	if signer != msg.Address {
+		return errInvalidSigner
+	}
+	return nil
+}
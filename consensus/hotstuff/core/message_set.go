@@ -0,0 +1,68 @@
+package core
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+)
+
+// messageSet collects the messages of one type sent by distinct validators in a view.
+type messageSet struct {
+	valSet     interfaces.ValidatorSet
+	messagesMu sync.Mutex
+	messages   map[common.Address]*Message
+}
+
+func newMessageSet(valSet interfaces.ValidatorSet) *messageSet {
+	return &messageSet{
+		messages: make(map[common.Address]*Message),
+		valSet:   valSet,
+	}
+}
+
+// Add stores the message if its sender is a member of the validator set.
+func (ms *messageSet) Add(msg *Message) error {
+	ms.messagesMu.Lock()
+	defer ms.messagesMu.Unlock()
+
+	if _, v := ms.valSet.GetByAddress(msg.Address); v == nil {
+		return errUnauthorizedAddress
+	}
+	ms.messages[msg.Address] = msg
+	return nil
+}
+
+func (ms *messageSet) Values() (result []*Message) {
+	ms.messagesMu.Lock()
+	defer ms.messagesMu.Unlock()
+
+	for _, v := range ms.messages {
+		result = append(result, v)
+	}
+	return result
+}
+
+func (ms *messageSet) Size() int {
+	ms.messagesMu.Lock()
+	defer ms.messagesMu.Unlock()
+	return len(ms.messages)
+}
+
+func (ms *messageSet) Get(addr common.Address) *Message {
+	ms.messagesMu.Lock()
+	defer ms.messagesMu.Unlock()
+	return ms.messages[addr]
+}
+
+func (ms *messageSet) String() string {
+	ms.messagesMu.Lock()
+	defer ms.messagesMu.Unlock()
+	addresses := make([]string, 0, len(ms.messages))
+	for _, v := range ms.messages {
+		addresses = append(addresses, v.Address.String())
+	}
+	return fmt.Sprintf("[%v]", strings.Join(addresses, ", "))
+}
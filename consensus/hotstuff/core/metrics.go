@@ -0,0 +1,92 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/metrics"
+)
+
+var (
+	// phaseTimers measure the time a view takes to reach each state since it started
+	phaseTimers = map[State]metrics.Timer{
+		StatePrepared:     metrics.NewRegisteredTimer("consensus/hotstuff/phase/prepare", nil),
+		StatePreCommitted: metrics.NewRegisteredTimer("consensus/hotstuff/phase/precommit", nil),
+		StateCommitted:    metrics.NewRegisteredTimer("consensus/hotstuff/phase/commit", nil),
+		StateDecided:      metrics.NewRegisteredTimer("consensus/hotstuff/phase/decide", nil),
+	}
+
+	roundChangeCounter = metrics.NewRegisteredCounter("consensus/hotstuff/round/change", nil)
+	timeoutCounter     = metrics.NewRegisteredCounter("consensus/hotstuff/round/timeout", nil)
+
+	proposerSelfCounter   = metrics.NewRegisteredCounter("consensus/hotstuff/proposer/self", nil)
+	proposerOthersCounter = metrics.NewRegisteredCounter("consensus/hotstuff/proposer/others", nil)
+
+	qcSizeHistogram = metrics.NewRegisteredHistogram("consensus/hotstuff/qc/size", nil, metrics.NewExpDecaySample(1028, 0.015))
+	backlogGauge    = metrics.NewRegisteredGauge("consensus/hotstuff/backlog", nil)
+)
+
+const (
+	votesReceivedPrefix = "consensus/hotstuff/votes/received/" // Votes accepted from each validator
+	votesMissingPrefix  = "consensus/hotstuff/votes/missing/"  // Votes of each validator missing from the QCs built locally
+)
+
+// setState moves the current view to the given state, recording the time it
+// took to reach it since the view started.
+func (c *Core) setState(state State) {
+	c.current.SetState(state)
+	if timer := phaseTimers[state]; timer != nil {
+		timer.UpdateSince(c.current.Start())
+	}
+}
+
+// markVoteReceived counts an accepted vote of the validator.
+func markVoteReceived(addr common.Address) {
+	metrics.GetOrRegisterCounter(votesReceivedPrefix+addr.Hex(), nil).Inc(1)
+}
+
+// markQC records the size of a QC built locally, and counts the votes of the
+// validators missing from it.
+func (c *Core) markQC(qc *QuorumCert) {
+	qcSizeHistogram.Update(int64(len(qc.Signers)))
+	if !metrics.Enabled {
+		return
+	}
+	signed := make(map[common.Address]struct{}, len(qc.Signers))
+	for _, addr := range qc.Signers {
+		signed[addr] = struct{}{}
+	}
+	for _, addr := range c.valSet.AddressList() {
+		if _, ok := signed[addr]; !ok {
+			metrics.GetOrRegisterCounter(votesMissingPrefix+addr.Hex(), nil).Inc(1)
+		}
+	}
+}
+
+// updateBacklogGauge reports the number of future messages waiting in the
+// backlogs. It must be called with backlogsMu held.
+func (c *Core) updateBacklogGauge() {
+	var size int
+	for _, backlog := range c.backlogs {
+		if backlog != nil {
+			size += backlog.Size()
+		}
+	}
+	backlogGauge.Update(int64(size))
+}
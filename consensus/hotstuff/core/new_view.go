@@ -1 +1,84 @@
 package core
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// sendNewView reports the highest prepare QC known by the replica, together with
+// the proposal it certifies, to the proposer of the current view.
+func (c *Core) sendNewView() {
+	logger := c.newLogger()
+
+	newView := &MsgNewView{
+		HighQC:   c.current.PrepareQC(),
+		Proposal: c.current.Prepared(),
+	}
+	if newView.HighQC == nil || newView.Proposal == nil {
+		newView.HighQC, newView.Proposal = nil, nil
+	}
+	payload, err := rlp.EncodeToBytes(newView)
+	if err != nil {
+		logger.Error("Failed to encode new view", "err", err)
+		return
+	}
+
+	logger.Trace("Send new view", "proposer", c.valSet.GetProposer(), "highQC", newView.HighQC)
+	c.unicast(c.valSet, &Message{
+		Code: MsgTypeNewView,
+		Msg:  payload,
+	})
+}
+
+// handleNewView collects the NEW_VIEW messages of the current view. Once 2f+1 of
+// them are gathered, the proposer adopts the highest QC among them and proposes.
+func (c *Core) handleNewView(msg *Message, src interfaces.Validator) error {
+	logger := c.newLogger().New("from", src.Address())
+
+	if err := c.checkView(msg.Code, msg.View); err != nil {
+		return err
+	}
+	if err := c.checkMsgToProposer(); err != nil {
+		return err
+	}
+
+	var newView *MsgNewView
+	if err := msg.Decode(&newView); err != nil {
+		return errDecodeFailed
+	}
+	if highQC := newView.HighQC; highQC != nil {
+		if newView.Proposal == nil || newView.Proposal.Hash() != highQC.Hash || highQC.View.Height.Cmp(msg.View.Height) != 0 {
+			return errInvalidQC
+		}
+		if err := c.verifyQC(highQC, MsgTypePrepareVote, c.valSet); err != nil {
+			logger.Warn("Invalid high qc in new view", "err", err)
+			return err
+		}
+		if prepareQC := c.current.PrepareQC(); prepareQC == nil || highQC.View.Cmp(prepareQC.View) > 0 {
+			c.current.SetPrepareQC(highQC)
+			c.current.SetPrepared(newView.Proposal)
+		}
+	}
+
+	newViews := c.current.Votes(MsgTypeNewView)
+	if err := newViews.Add(msg); err != nil {
+		logger.Error("Failed to add new view", "err", err)
+		return err
+	}
+	logger.Trace("Accept new view", "size", newViews.Size(), "quorum", c.valSet.Q())
+
+	if c.readyToPropose() && c.current.State() == StateAcceptRequest && c.current.Proposal() == nil {
+		c.sendPrepare()
+	}
+	return nil
+}
+
+// readyToPropose returns whether the proposer may propose in the current view.
+// The first round of a height follows a decided block, later rounds follow a
+// view change and wait for 2f+1 NEW_VIEW messages.
+func (c *Core) readyToPropose() bool {
+	if c.current.Round().Sign() == 0 {
+		return true
+	}
+	return c.current.Votes(MsgTypeNewView).Size() >= c.valSet.Q()
+}
@@ -0,0 +1,56 @@
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+)
+
+// handlePreCommit accepts the prepare QC of the current proposal and votes pre-commit.
+func (c *Core) handlePreCommit(msg *Message, src interfaces.Validator) error {
+	logger := c.newLogger().New("from", src.Address())
+
+	qc, err := c.checkPhaseQC(msg, src, StatePrepared, MsgTypePrepareVote)
+	if err != nil {
+		return err
+	}
+
+	logger.Trace("Accept pre-commit", "qc", qc)
+
+	c.current.SetPrepareQC(qc)
+	c.current.SetPrepared(c.current.Proposal())
+	c.setState(StatePreCommitted)
+	c.sendVote(MsgTypePreCommitVote, qc.Hash)
+	c.processBacklog()
+	return nil
+}
+
+// checkPhaseQC validates a QC broadcast by the proposer while the local replica
+// is expected to be in the given state. Messages of a phase that the replica has
+// not reached yet are reported as future messages.
+func (c *Core) checkPhaseQC(msg *Message, src interfaces.Validator, state State, code MsgType) (*QuorumCert, error) {
+	if err := c.checkView(msg.Code, msg.View); err != nil {
+		return nil, err
+	}
+	if err := c.checkMsgFromProposer(src); err != nil {
+		return nil, err
+	}
+	if res := c.current.State().Cmp(state); res < 0 {
+		return nil, errFutureMessage
+	} else if res > 0 {
+		return nil, errAlreadyVoted
+	}
+
+	var qc *QuorumCert
+	if err := msg.Decode(&qc); err != nil {
+		return nil, errDecodeFailed
+	}
+	if qc.View == nil || qc.View.Cmp(msg.View) != 0 {
+		return nil, errInvalidQC
+	}
+	if proposal := c.current.Proposal(); proposal == nil || proposal.Hash() != qc.Hash {
+		return nil, errInvalidDigest
+	}
+	if err := c.verifyQC(qc, code, c.valSet); err != nil {
+		return nil, err
+	}
+	return qc, nil
+}
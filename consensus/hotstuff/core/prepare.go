@@ -0,0 +1,177 @@
+package core
+
+import (
+	"time"
+
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// sendPrepare proposes the pending request of the current view together with the
+// highest prepare QC known by the proposer. If that QC certifies a proposal of
+// the current height, the certified proposal is proposed again instead.
+func (c *Core) sendPrepare() {
+	logger := c.newLogger()
+
+	proposal := c.current.Prepared()
+	if proposal == nil {
+		request := c.current.PendingRequest()
+		if request == nil {
+			logger.Trace("No pending request to propose")
+			return
+		}
+		block, ok := request.Proposal.(*types.Block)
+		if !ok {
+			logger.Error("Invalid pending request", "hash", request.Proposal.Hash())
+			return
+		}
+		// Fresh proposals are sealed for the round they are proposed in, while the
+		// prepared ones keep the seal of the round they were first proposed in
+		sealed, err := c.backend.SealProposal(block, c.current.Round().Uint64())
+		if err != nil {
+			logger.Error("Failed to seal proposal", "hash", block.Hash(), "err", err)
+			return
+		}
+		if proposal, ok = sealed.(*types.Block); !ok {
+			logger.Error("Invalid sealed proposal", "hash", sealed.Hash())
+			return
+		}
+	}
+
+	// The chained proposal extends the head, justified by its QC
+	highQC := c.current.PrepareQC()
+	if c.config.Chained {
+		highQC = c.headQC()
+	}
+	payload, err := rlp.EncodeToBytes(&MsgPrepare{
+		Proposal: proposal,
+		HighQC:   highQC,
+	})
+	if err != nil {
+		logger.Error("Failed to encode prepare message", "err", err)
+		return
+	}
+
+	logger.Trace("Send prepare", "number", proposal.Number(), "hash", proposal.Hash())
+	c.current.SetProposal(proposal)
+	c.broadcast(&Message{
+		Code: MsgTypePrepare,
+		Msg:  payload,
+	})
+}
+
+// handlePrepare validates the proposal of the current view and votes for it if
+// it is safe to do so.
+func (c *Core) handlePrepare(msg *Message, src interfaces.Validator) error {
+	logger := c.newLogger().New("from", src.Address())
+
+	if err := c.checkView(msg.Code, msg.View); err != nil {
+		return err
+	}
+	if err := c.checkMsgFromProposer(src); err != nil {
+		logger.Warn("Ignore prepare messages from non-proposer")
+		return err
+	}
+	if c.current.State().Cmp(StateAcceptRequest) > 0 {
+		return errAlreadyVoted
+	}
+
+	var prepare *MsgPrepare
+	if err := msg.Decode(&prepare); err != nil {
+		return errDecodeFailed
+	}
+	proposal := prepare.Proposal
+	if proposal == nil || proposal.Number().Cmp(msg.View.Height) != 0 {
+		return errInvalidProposal
+	}
+	// a proposal certified in an earlier round keeps the coinbase of its author
+	if proposal.Coinbase() != src.Address() && (prepare.HighQC == nil || prepare.HighQC.Hash != proposal.Hash()) {
+		return errInvalidProposal
+	}
+
+	// Verify the proposal we received
+	if duration, err := c.backend.VerifyUnsealedProposal(proposal); err != nil {
+		logger.Warn("Failed to verify proposal", "err", err, "duration", duration)
+		// if it's a future block, we will handle it again after the duration
+		if err == consensus.ErrFutureBlock {
+			time.AfterFunc(duration, func() {
+				c.sendEvent(backlogEvent{msg: msg})
+			})
+			return errFutureMessage
+		}
+		return err
+	}
+
+	// The high QC justifies the proposal, so its seal is checked before anything
+	// relies on it. The chained one certifies the parent, with the validators of
+	// the parent height.
+	if highQC := prepare.HighQC; highQC != nil {
+		code, valSet := MsgTypePrepareVote, c.valSet
+		if c.config.Chained {
+			code, valSet = MsgTypeGenericVote, c.backend.Validators(proposal.NumberU64()-1)
+		}
+		if err := c.verifyQC(highQC, code, valSet); err != nil {
+			logger.Warn("Invalid high qc in prepare", "err", err)
+			return err
+		}
+	}
+	if c.config.Chained {
+		parent, err := c.checkChainedSafety(proposal, prepare.HighQC)
+		if err != nil {
+			logger.Warn("Unsafe proposal", "hash", proposal.Hash(), "err", err)
+			return err
+		}
+		// The certified parent extends the chain, which may lock and commit its
+		// ancestors
+		if prepare.HighQC != nil {
+			if err := c.updateQC(parent, prepare.HighQC); err != nil {
+				logger.Warn("Failed to certify parent", "hash", parent.Hash(), "err", err)
+				return err
+			}
+		}
+	} else {
+		// Safety rule: a replica locked on a proposal only accepts another one of
+		// the same height if it justifies a higher prepare QC than the lock.
+		if err := c.checkSafety(proposal, prepare.HighQC); err != nil {
+			logger.Warn("Unsafe proposal", "hash", proposal.Hash(), "err", err)
+			return err
+		}
+	}
+
+	if err := c.backend.ValidateBlock(proposal); err != nil {
+		logger.Warn("Failed to validate block", "hash", proposal.Hash(), "err", err)
+		return errBADProposal
+	}
+
+	logger.Trace("Accept prepare", "number", proposal.Number(), "hash", proposal.Hash())
+
+	c.current.SetProposal(proposal)
+	c.setState(StatePrepared)
+	if c.config.Chained {
+		c.current.SetNextValSet(c.nextValidators(proposal))
+		c.sendVote(MsgTypeGenericVote, proposal.Hash())
+		// replay the votes the next proposer received before the proposal
+		c.processBacklog()
+		return nil
+	}
+	c.sendVote(MsgTypePrepareVote, proposal.Hash())
+	return nil
+}
+
+// checkSafety implements the safe node predicate of hotstuff
+func (c *Core) checkSafety(proposal *types.Block, highQC *QuorumCert) error {
+	// a proposal justified by a QC of its own height must be the certified one
+	if highQC != nil && highQC.View.Height.Cmp(proposal.Number()) == 0 && highQC.Hash != proposal.Hash() {
+		return errUnsafeProposal
+	}
+	lockedQC := c.current.LockedQC()
+	if lockedQC == nil || lockedQC.Hash == proposal.Hash() {
+		return nil
+	}
+	if highQC != nil && highQC.View.Height.Cmp(lockedQC.View.Height) == 0 && highQC.View.Round.Cmp(lockedQC.View.Round) > 0 {
+		return nil
+	}
+	return errUnsafeProposal
+}
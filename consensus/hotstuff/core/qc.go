@@ -0,0 +1,73 @@
+package core
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	blst "github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	common2 "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+)
+
+// aggregateQC assembles the collected votes for the current proposal into a QC
+// carrying the aggregated BLS seal of the voters.
+func (c *Core) aggregateQC(code MsgType, votes *messageSet) (*QuorumCert, error) {
+	proposal := c.current.Proposal()
+	if proposal == nil {
+		return nil, errInvalidProposal
+	}
+
+	msgs := votes.Values()
+	sort.Slice(msgs, func(i, j int) bool {
+		return bytes.Compare(msgs[i].Address.Bytes(), msgs[j].Address.Bytes()) < 0
+	})
+	signers := make([]common.Address, 0, len(msgs))
+	sigs := make([]common2.Signature, 0, len(msgs))
+	for _, msg := range msgs {
+		sig, err := blst.SignatureFromBytes(msg.CommittedSeal)
+		if err != nil {
+			return nil, err
+		}
+		signers = append(signers, msg.Address)
+		sigs = append(sigs, sig)
+	}
+
+	qc := &QuorumCert{
+		View:     c.current.View(),
+		Code:     code,
+		Hash:     proposal.Hash(),
+		Proposer: proposal.Coinbase(),
+		Signers:  signers,
+		Seal:     c.signer.BlsSigner.AggregateSignatures(sigs).Marshal(),
+	}
+	c.markQC(qc)
+	return qc, nil
+}
+
+// verifyQC checks the QC aggregates the votes of the given code from at least
+// 2f+1 distinct validators of the validator set of the certified proposal.
+func (c *Core) verifyQC(qc *QuorumCert, code MsgType, valSet interfaces.ValidatorSet) error {
+	if qc == nil || qc.View == nil || qc.View.Height == nil || qc.View.Round == nil {
+		return errInvalidQC
+	}
+	if qc.Code != code {
+		return errInvalidQC
+	}
+
+	seen := make(map[common.Address]struct{}, len(qc.Signers))
+	for _, addr := range qc.Signers {
+		if _, ok := seen[addr]; ok {
+			return errInvalidQC
+		}
+		seen[addr] = struct{}{}
+
+		if _, val := valSet.GetByAddress(addr); val == nil {
+			return errUnauthorizedAddress
+		}
+	}
+	if len(qc.Signers) < valSet.Q() {
+		return errInsufficientQC
+	}
+	return c.signer.BlsSigner.VerifyAggregatedSignature(valSet, qc.Signers, qc.Seal, VoteDigest(qc.Code, qc.View, qc.Hash))
+}
@@ -0,0 +1,47 @@
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// handleRequest stores the block sealed by the local miner as the pending request
+// of the current height, and proposes it if the local node is the proposer.
+func (c *Core) handleRequest(request *interfaces.Request) error {
+	logger := c.newLogger()
+
+	if err := c.checkRequestMsg(request); err != nil {
+		if err == errInvalidMessage {
+			logger.Warn("Invalid request")
+			return err
+		}
+		logger.Warn("Unexpected request", "err", err, "number", request.Proposal.Number(), "hash", request.Proposal.Hash())
+		return err
+	}
+	logger.Trace("Received request", "number", request.Proposal.Number(), "hash", request.Proposal.Hash())
+
+	c.current.SetPendingRequest(request)
+	if c.IsProposer() && c.readyToPropose() && c.current.State() == StateAcceptRequest && c.current.Proposal() == nil {
+		c.sendPrepare()
+	}
+	return nil
+}
+
+// checkRequestMsg checks that the request proposes a block for the current height
+func (c *Core) checkRequestMsg(request *interfaces.Request) error {
+	if request == nil || request.Proposal == nil {
+		return errInvalidMessage
+	}
+	if _, ok := request.Proposal.(*types.Block); !ok {
+		return errInvalidProposal
+	}
+	if c.current == nil {
+		return ErrStoppedEngine
+	}
+	if c := c.current.Height().Cmp(request.Proposal.Number()); c > 0 {
+		return errOldMessage
+	} else if c < 0 {
+		return errFutureMessage
+	}
+	return nil
+}
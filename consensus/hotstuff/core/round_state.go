@@ -0,0 +1,214 @@
+package core
+
+import (
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// roundState stores the consensus state of the local replica for one view.
+// The QCs, the prepared proposal and the pending request survive round changes
+// within a height, and are dropped once the height is decided, except for the
+// high QC and the lock of the chained mode, which span the heights.
+type roundState struct {
+	vs             interfaces.ValidatorSet
+	round          *big.Int
+	height         *big.Int
+	start          time.Time // time the view was entered
+	state          State
+	pendingRequest *interfaces.Request
+	proposal       *types.Block
+	prepared       *types.Block // proposal certified by prepareQC
+	decided        common.Hash  // hash of the sealed proposal delivered to the backend
+
+	nextValSet interfaces.ValidatorSet // validators of the proposal's child, in the chained mode
+
+	newViews       *messageSet
+	prepareVotes   *messageSet
+	preCommitVotes *messageSet
+	commitVotes    *messageSet
+	genericVotes   *messageSet
+
+	prepareQC *QuorumCert // highest prepare QC of the height, or generic QC in the chained mode
+	lockedQC  *QuorumCert // pre-commit QC the replica is locked on, or generic QC of its two-chain
+	commitQC  *QuorumCert // commit QC of the current view
+
+	mu sync.RWMutex
+}
+
+func newRoundState(view *View, validatorSet interfaces.ValidatorSet, prepareQC, lockedQC *QuorumCert, prepared *types.Block) *roundState {
+	return &roundState{
+		vs:             validatorSet,
+		round:          view.Round,
+		height:         view.Height,
+		start:          time.Now(),
+		state:          StateAcceptRequest,
+		prepared:       prepared,
+		newViews:       newMessageSet(validatorSet),
+		prepareVotes:   newMessageSet(validatorSet),
+		preCommitVotes: newMessageSet(validatorSet),
+		commitVotes:    newMessageSet(validatorSet),
+		genericVotes:   newMessageSet(validatorSet),
+		prepareQC:      prepareQC,
+		lockedQC:       lockedQC,
+	}
+}
+
+func (s *roundState) View() *View {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return &View{
+		Round:  new(big.Int).Set(s.round),
+		Height: new(big.Int).Set(s.height),
+	}
+}
+
+func (s *roundState) Round() *big.Int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.round
+}
+
+func (s *roundState) Height() *big.Int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.height
+}
+
+// Start returns the time the view was entered.
+func (s *roundState) Start() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.start
+}
+
+func (s *roundState) SetState(state State) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.state = state
+}
+
+func (s *roundState) State() State {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.state
+}
+
+func (s *roundState) SetPendingRequest(req *interfaces.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pendingRequest = req
+}
+
+func (s *roundState) PendingRequest() *interfaces.Request {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.pendingRequest
+}
+
+func (s *roundState) SetProposal(proposal *types.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.proposal = proposal
+}
+
+func (s *roundState) Proposal() *types.Block {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.proposal
+}
+
+func (s *roundState) SetPrepareQC(qc *QuorumCert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prepareQC = qc
+}
+
+func (s *roundState) SetPrepared(proposal *types.Block) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.prepared = proposal
+}
+
+func (s *roundState) Prepared() *types.Block {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.prepared
+}
+
+func (s *roundState) PrepareQC() *QuorumCert {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.prepareQC
+}
+
+func (s *roundState) SetLockedQC(qc *QuorumCert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.lockedQC = qc
+}
+
+func (s *roundState) LockedQC() *QuorumCert {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.lockedQC
+}
+
+func (s *roundState) SetCommitQC(qc *QuorumCert) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.commitQC = qc
+}
+
+func (s *roundState) CommitQC() *QuorumCert {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.commitQC
+}
+
+func (s *roundState) SetDecided(hash common.Hash) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.decided = hash
+}
+
+func (s *roundState) Decided() common.Hash {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.decided
+}
+
+func (s *roundState) SetNextValSet(valSet interfaces.ValidatorSet) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nextValSet = valSet
+}
+
+func (s *roundState) NextValSet() interfaces.ValidatorSet {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.nextValSet
+}
+
+// Votes returns the message set collecting the NEW_VIEW messages or the votes of the given code.
+func (s *roundState) Votes(code MsgType) *messageSet {
+	switch code {
+	case MsgTypeNewView:
+		return s.newViews
+	case MsgTypePrepareVote:
+		return s.prepareVotes
+	case MsgTypePreCommitVote:
+		return s.preCommitVotes
+	case MsgTypeCommitVote:
+		return s.commitVotes
+	case MsgTypeGenericVote:
+		return s.genericVotes
+	default:
+		return nil
+	}
+}
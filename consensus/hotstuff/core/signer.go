@@ -6,27 +6,40 @@ import (
 	"github.com/ethereum/go-ethereum/core/types"
 )
 
+// RemoteSigner holds the leader and consensus keys of a validator in another
+// process, such as clef, and signs on its behalf. The requests carry what is
+// signed rather than its hash, for the remote signer to refuse the ones which
+// would equivocate.
+type RemoteSigner interface {
+	// Address returns the account of the leader key.
+	Address() common.Address
+
+	// PublicKey returns the BLS public key of the consensus key.
+	PublicKey() []byte
+
+	// SignSeal signs the leader seal of the header.
+	SignSeal(header *types.Header) ([]byte, error)
+
+	// SignMessage signs the rlp encoding of a consensus message without signature.
+	SignMessage(payload []byte) ([]byte, error)
+
+	// SignVote signs the vote of the given phase of the view with the consensus key.
+	SignVote(code MsgType, view *View, digest common.Hash) ([]byte, error)
+
+	// SignVRF signs the proof of the election of the proposer in the given round
+	// with the consensus key.
+	SignVRF(parent common.Hash, round uint64) ([]byte, error)
+}
+
 type Signer struct {
 	EthSigner *EthSigner
 	BlsSigner *BlsSigner
 }
 
-func (s *Signer) VerifyHeader(header *types.Header, valSet interfaces.ValidatorSet, seal bool) error {
+func (s *Signer) VerifyHeader(header *types.Header, valSet interfaces.ValidatorSet, chained bool, seal bool) error {
 	res := s.EthSigner.VerifyLeaderSeal(header)
 	if res == nil && seal {
-		res = s.BlsSigner.VerifyValidatorSeal(header, valSet)
+		res = s.BlsSigner.VerifyValidatorSeal(header, valSet, chained)
 	}
 	return res
 }
-
-func (s *Signer) GetValidators(valNum int) ([]common.Address, error) {
-	vals := make([]common.Address, valNum)
-	for i := 0; i < valNum; i++ {
-		if val, err := s.EthSigner.GetValidator(i); err != nil {
-			return nil, err
-		} else {
-			vals[i] = val
-		}
-	}
-	return vals, nil
-}
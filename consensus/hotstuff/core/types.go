@@ -1,5 +1,16 @@
 package core
 
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
 type MsgType uint64
 
 const (
@@ -11,6 +22,8 @@ const (
 	MsgTypeCommit        MsgType = 6
 	MsgTypeCommitVote    MsgType = 7
 	MsgTypeDecide        MsgType = 8
+	MsgTypeEvidence      MsgType = 9
+	MsgTypeGenericVote   MsgType = 10
 )
 
 func (m MsgType) String() string {
@@ -31,6 +44,10 @@ func (m MsgType) String() string {
 		return "COMMIT_VOTE"
 	case MsgTypeDecide:
 		return "DECIDE"
+	case MsgTypeEvidence:
+		return "EVIDENCE"
+	case MsgTypeGenericVote:
+		return "GENERIC_VOTE"
 	default:
 		return "UNKNOWN"
 	}
@@ -39,3 +56,191 @@ func (m MsgType) String() string {
 func (m MsgType) Value() uint64 {
 	return uint64(m)
 }
+
+// State is the phase of the current view from the point of view of the local replica.
+type State uint64
+
+const (
+	StateAcceptRequest State = iota
+	StatePrepared
+	StatePreCommitted
+	StateCommitted
+	StateDecided
+)
+
+func (s State) String() string {
+	switch s {
+	case StateAcceptRequest:
+		return "Accept request"
+	case StatePrepared:
+		return "Prepared"
+	case StatePreCommitted:
+		return "PreCommitted"
+	case StateCommitted:
+		return "Committed"
+	case StateDecided:
+		return "Decided"
+	default:
+		return "Unknown"
+	}
+}
+
+// Cmp compares s and y and returns:
+//
+//	-1 if s is the previous state of y
+//	 0 if s and y are the same state
+//	+1 if s is the next state of y
+func (s State) Cmp(y State) int {
+	if uint64(s) < uint64(y) {
+		return -1
+	}
+	if uint64(s) > uint64(y) {
+		return 1
+	}
+	return 0
+}
+
+// View includes a round number and a block height number.
+// Height is the block number we'd like to commit, and each round has a number
+// and starts from 0. The view is increased by one round at a time until the
+// proposal of the height is decided.
+type View struct {
+	Round  *big.Int
+	Height *big.Int
+}
+
+func (v *View) String() string {
+	return fmt.Sprintf("{Round: %d, Height: %d}", v.Round.Uint64(), v.Height.Uint64())
+}
+
+// Cmp compares v and y and returns:
+//
+//	-1 if v <  y
+//	 0 if v == y
+//	+1 if v >  y
+func (v *View) Cmp(y *View) int {
+	if v.Height.Cmp(y.Height) != 0 {
+		return v.Height.Cmp(y.Height)
+	}
+	if v.Round.Cmp(y.Round) != 0 {
+		return v.Round.Cmp(y.Round)
+	}
+	return 0
+}
+
+// succeeds reports whether v directly follows the parent view, that is whether
+// it is the first round of the next height.
+func (v *View) succeeds(parent *View) bool {
+	return v.Round.Sign() == 0 && v.Height.Cmp(new(big.Int).Add(parent.Height, common.Big1)) == 0
+}
+
+// Message is the envelope of every hotstuff consensus message. Signature is the
+// sender's ECDSA signature over the rlp encoding of the message without it, and
+// CommittedSeal carries the sender's BLS vote when the message is a vote.
+type Message struct {
+	Code          MsgType
+	View          *View
+	Msg           []byte
+	Address       common.Address
+	Signature     []byte
+	CommittedSeal []byte
+}
+
+// Payload returns the rlp encoding of the whole message.
+func (m *Message) Payload() ([]byte, error) {
+	return rlp.EncodeToBytes(m)
+}
+
+// PayloadNoSig returns the rlp encoding of the message without the signature,
+// which is the data signed by the sender.
+func (m *Message) PayloadNoSig() ([]byte, error) {
+	return rlp.EncodeToBytes(&Message{
+		Code:          m.Code,
+		View:          m.View,
+		Msg:           m.Msg,
+		Address:       m.Address,
+		Signature:     []byte{},
+		CommittedSeal: m.CommittedSeal,
+	})
+}
+
+// Decode decodes the inner payload of the message into val.
+func (m *Message) Decode(val interface{}) error {
+	return rlp.DecodeBytes(m.Msg, val)
+}
+
+func (m *Message) String() string {
+	return fmt.Sprintf("{Code: %v, Address: %v, View: %v}", m.Code, m.Address, m.View)
+}
+
+// Vote is the payload of PREPARE_VOTE, PRECOMMIT_VOTE, COMMIT_VOTE and, in the
+// chained mode, GENERIC_VOTE messages.
+type Vote struct {
+	Code   MsgType
+	View   *View
+	Digest common.Hash // hash of the proposal voted for
+}
+
+func (v *Vote) String() string {
+	return fmt.Sprintf("{Code: %v, View: %v, Digest: %v}", v.Code, v.View, v.Digest.Hex())
+}
+
+// QuorumCert is the aggregation of 2f+1 votes of the same type for the same proposal.
+type QuorumCert struct {
+	View     *View
+	Code     MsgType          // code of the votes aggregated in this certificate
+	Hash     common.Hash      // hash of the certified proposal
+	Proposer common.Address   // proposer of the certified proposal
+	Signers  []common.Address // validators whose votes are aggregated
+	Seal     []byte           // aggregated BLS signature of the signers
+}
+
+func (qc *QuorumCert) String() string {
+	return fmt.Sprintf("{Code: %v, View: %v, Hash: %v, Signers: %d}", qc.Code, qc.View, qc.Hash.Hex(), len(qc.Signers))
+}
+
+// MsgPrepare is the payload of a PREPARE message. HighQC is the highest prepare
+// QC known by the proposer for the proposal's height, if any. In the chained mode
+// it is the generic QC of the proposal's parent instead.
+type MsgPrepare struct {
+	Proposal *types.Block
+	HighQC   *QuorumCert `rlp:"nil"`
+}
+
+// MsgNewView is the payload of a NEW_VIEW message. HighQC is the highest prepare
+// QC known by the sender for the current height, and Proposal is the block it
+// certifies, which the next proposer must propose again.
+type MsgNewView struct {
+	HighQC   *QuorumCert  `rlp:"nil"`
+	Proposal *types.Block `rlp:"nil"`
+}
+
+// VoteDigest returns the message a validator BLS-signs when voting with the given
+// code in the view. The code and the view are signed along the proposal hash, so
+// that a QC can't be passed off as a QC of another phase or of a higher view. The
+// QCs written into the block headers record the round of their view for this.
+func VoteDigest(code MsgType, view *View, hash common.Hash) common.Hash {
+	return rlpHash([]interface{}{code, view, hash})
+}
+
+// SealCode returns the code of the votes aggregated into the block seals, which
+// are the generic votes in the chained mode and the commit votes otherwise.
+func SealCode(chained bool) MsgType {
+	if chained {
+		return MsgTypeGenericVote
+	}
+	return MsgTypeCommitVote
+}
+
+// VRFMessage returns the message the proposer signs to prove its election in
+// the given round, on top of the given parent.
+func VRFMessage(parent common.Hash, round uint64) common.Hash {
+	var enc [8]byte
+	binary.BigEndian.PutUint64(enc[:], round)
+	return crypto.Keccak256Hash(parent[:], enc[:])
+}
+
+func rlpHash(x interface{}) (h common.Hash) {
+	data, _ := rlp.EncodeToBytes(x)
+	return crypto.Keccak256Hash(data)
+}
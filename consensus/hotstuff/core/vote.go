@@ -0,0 +1,173 @@
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// sendVote signs the digest with the consensus key and sends the vote to the
+// proposer of the current view, or to the proposer of the next height for the
+// generic votes, which aggregates them into the QC of its proposal. The vote is
+// recorded in the WAL first, and it is never sent twice for the same phase of a
+// view, even across restarts.
+func (c *Core) sendVote(code MsgType, digest common.Hash) {
+	logger := c.newLogger()
+
+	view := c.current.View()
+	if c.lastVote.voted(code, view) {
+		logger.Warn("Refuse to vote again", "code", code, "digest", digest, "last", c.lastVote.View, "lastCode", c.lastVote.Code)
+		return
+	}
+	payload, err := rlp.EncodeToBytes(&Vote{
+		Code:   code,
+		View:   view,
+		Digest: digest,
+	})
+	if err != nil {
+		logger.Error("Failed to encode vote", "code", code, "err", err)
+		return
+	}
+	if err := c.writeWAL(code, view, digest); err != nil {
+		logger.Error("Failed to write WAL", "code", code, "err", err)
+		return
+	}
+	seal, err := c.signer.BlsSigner.SignVote(code, view, digest)
+	if err != nil {
+		logger.Error("Failed to sign vote", "code", code, "err", err)
+		return
+	}
+
+	valSet := c.valSet
+	if code == MsgTypeGenericVote {
+		valSet = c.current.NextValSet()
+	}
+	logger.Trace("Send vote", "code", code, "digest", digest)
+	c.unicast(valSet, &Message{
+		Code:          code,
+		View:          view,
+		Msg:           payload,
+		CommittedSeal: seal,
+	})
+}
+
+// handleVote collects the votes of the current proposal, and moves the proposer
+// to the next phase once 2f+1 of them are gathered. Generic votes are collected
+// by the proposer of the next height, which certifies the proposal with them.
+func (c *Core) handleVote(msg *Message, src interfaces.Validator) error {
+	logger := c.newLogger().New("from", src.Address(), "code", msg.Code)
+
+	if err := c.checkView(msg.Code, msg.View); err != nil {
+		return err
+	}
+	if msg.Code != MsgTypeGenericVote {
+		if err := c.checkMsgToProposer(); err != nil {
+			return err
+		}
+	}
+
+	var vote *Vote
+	if err := msg.Decode(&vote); err != nil {
+		return errDecodeFailed
+	}
+	if vote.Code != msg.Code || vote.View == nil || vote.View.Cmp(msg.View) != 0 {
+		return errInvalidMessage
+	}
+	// the proposer may receive votes before handling its own proposal
+	proposal := c.current.Proposal()
+	if proposal == nil {
+		return errFutureMessage
+	}
+	if msg.Code == MsgTypeGenericVote {
+		if err := c.checkMsgToNextProposer(); err != nil {
+			return err
+		}
+	}
+	if vote.Digest != proposal.Hash() {
+		logger.Warn("Inconsistent vote digest", "expect", proposal.Hash(), "got", vote.Digest)
+		return errInvalidDigest
+	}
+	if err := c.signer.BlsSigner.VerifySignature(c.valSet, src.Address(), msg.CommittedSeal, VoteDigest(vote.Code, vote.View, vote.Digest)); err != nil {
+		logger.Warn("Invalid vote seal", "err", err)
+		return err
+	}
+
+	votes := c.current.Votes(msg.Code)
+	if err := votes.Add(msg); err != nil {
+		logger.Error("Failed to add vote", "err", err)
+		return err
+	}
+	logger.Trace("Accept vote", "size", votes.Size(), "quorum", c.valSet.Q())
+	markVoteReceived(src.Address())
+
+	if votes.Size() < c.valSet.Q() {
+		return nil
+	}
+
+	switch msg.Code {
+	case MsgTypePrepareVote:
+		if c.isCurrentQC(c.current.PrepareQC()) {
+			return nil
+		}
+		qc, err := c.aggregateQC(msg.Code, votes)
+		if err != nil {
+			logger.Error("Failed to aggregate prepare qc", "err", err)
+			return err
+		}
+		c.current.SetPrepareQC(qc)
+		c.current.SetPrepared(c.current.Proposal())
+		c.sendQC(MsgTypePreCommit, qc)
+	case MsgTypePreCommitVote:
+		if c.isCurrentQC(c.current.LockedQC()) {
+			return nil
+		}
+		qc, err := c.aggregateQC(msg.Code, votes)
+		if err != nil {
+			logger.Error("Failed to aggregate pre-commit qc", "err", err)
+			return err
+		}
+		c.current.SetLockedQC(qc)
+		c.sendQC(MsgTypeCommit, qc)
+	case MsgTypeCommitVote:
+		if c.isCurrentQC(c.current.CommitQC()) {
+			return nil
+		}
+		qc, err := c.aggregateQC(msg.Code, votes)
+		if err != nil {
+			logger.Error("Failed to aggregate commit qc", "err", err)
+			return err
+		}
+		c.current.SetCommitQC(qc)
+		c.sendQC(MsgTypeDecide, qc)
+	case MsgTypeGenericVote:
+		if c.isCurrentQC(c.current.CommitQC()) {
+			return nil
+		}
+		qc, err := c.aggregateQC(msg.Code, votes)
+		if err != nil {
+			logger.Error("Failed to aggregate generic qc", "err", err)
+			return err
+		}
+		return c.certify(proposal, qc)
+	}
+	return nil
+}
+
+// sendQC broadcasts a QC formed by the proposer as the message of the next phase.
+func (c *Core) sendQC(code MsgType, qc *QuorumCert) {
+	payload, err := rlp.EncodeToBytes(qc)
+	if err != nil {
+		c.newLogger().Error("Failed to encode qc", "code", code, "err", err)
+		return
+	}
+	c.newLogger().Trace("Send qc", "code", code, "qc", qc)
+	c.broadcast(&Message{
+		Code: code,
+		Msg:  payload,
+	})
+}
+
+// isCurrentQC returns whether the QC is formed in the current view.
+func (c *Core) isCurrentQC(qc *QuorumCert) bool {
+	return qc != nil && qc.View.Cmp(c.current.View()) == 0
+}
@@ -0,0 +1,91 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package core
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// walEntry is the consensus state a replica must not forget across restarts:
+// the QCs it holds for the height, and the last vote it cast together with the
+// proposal it voted for. It is synced to disk before every vote is sent, so a
+// restarted validator resumes from it instead of voting the view again.
+type walEntry struct {
+	View      *View        // view of the last vote
+	Code      MsgType      // code of the last vote
+	Digest    common.Hash  // digest of the last vote
+	State     State        // local phase reached by the last vote
+	Proposal  *types.Block `rlp:"nil"` // proposal of the last vote
+	PrepareQC *QuorumCert  `rlp:"nil"`
+	LockedQC  *QuorumCert  `rlp:"nil"`
+	Prepared  *types.Block `rlp:"nil"`
+}
+
+// voted reports whether the entry records a vote at or after the given one,
+// in which case the replica must not vote again.
+func (w *walEntry) voted(code MsgType, view *View) bool {
+	if w == nil {
+		return false
+	}
+	if res := view.Cmp(w.View); res != 0 {
+		return res < 0
+	}
+	return code <= w.Code
+}
+
+// writeWAL persists the state of the current round along with the vote about
+// to be sent.
+func (c *Core) writeWAL(code MsgType, view *View, digest common.Hash) error {
+	entry := &walEntry{
+		View:      view,
+		Code:      code,
+		Digest:    digest,
+		State:     c.current.State(),
+		Proposal:  c.current.Proposal(),
+		PrepareQC: c.current.PrepareQC(),
+		LockedQC:  c.current.LockedQC(),
+		Prepared:  c.current.Prepared(),
+	}
+	blob, err := rlp.EncodeToBytes(entry)
+	if err != nil {
+		return err
+	}
+	if err := rawdb.WriteHotstuffWAL(c.db, c.Address(), blob); err != nil {
+		return err
+	}
+	c.lastVote = entry
+	return nil
+}
+
+// readWAL loads the state persisted by the last run, if any.
+func (c *Core) readWAL() *walEntry {
+	blob := rawdb.ReadHotstuffWAL(c.db, c.Address())
+	if len(blob) == 0 {
+		return nil
+	}
+	entry := new(walEntry)
+	if err := rlp.DecodeBytes(blob, entry); err != nil {
+		c.logger.Error("Failed to decode consensus WAL", "err", err)
+		return nil
+	}
+	return entry
+}
@@ -19,15 +19,238 @@
 package engine
 
 import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 )
 
+// API is a user facing RPC API to allow inspecting the validators and the rounds,
+// and controlling the voting mechanisms of the hotstuff engine.
 type API struct {
 	chain    consensus.ChainHeaderReader
 	hotstuff *HotStuffEngine
 }
 
+// View is the view of the round the local validator is working on.
+type View struct {
+	Height *big.Int `json:"height"`
+	Round  *big.Int `json:"round"`
+	Phase  string   `json:"phase"`
+}
+
+// QuorumCert is the decoded quorum certificate sealed into a block.
+type QuorumCert struct {
+	Number         uint64           `json:"number"`
+	Hash           common.Hash      `json:"hash"`
+	Proposer       common.Address   `json:"proposer"`
+	LeaderSeal     hexutil.Bytes    `json:"leaderSeal"`
+	AggregatedSeal hexutil.Bytes    `json:"aggregatedSeal"`
+	Bitmap         hexutil.Bytes    `json:"bitmap"`
+	Participants   []common.Address `json:"participants"`
+}
+
+type status struct {
+	Proposals     map[common.Address]int `json:"proposals"`     // Number of blocks proposed by each validator
+	Participation map[common.Address]int `json:"participation"` // Number of blocks sealed by each validator
+	NumBlocks     uint64                 `json:"numBlocks"`
+}
+
+// GetValidators retrieves the list of authorized validators at the specified block.
+func (api *API) GetValidators(number *rpc.BlockNumber) ([]common.Address, error) {
+	header, err := api.header(number)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := api.hotstuff.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return snap.Validators, nil
+}
+
+// GetValidatorsAtHash retrieves the list of authorized validators at the specified block.
+func (api *API) GetValidatorsAtHash(hash common.Hash) ([]common.Address, error) {
+	header := api.chain.GetHeaderByHash(hash)
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	snap, err := api.hotstuff.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return snap.Validators, nil
+}
+
+// GetCurrentView returns the height, round and phase the local validator is working on.
+func (api *API) GetCurrentView() (*View, error) {
+	height, round, phase, ok := api.hotstuff.core.CurrentView()
+	if !ok {
+		return nil, ErrStoppedEngine
+	}
+	return &View{Height: height, Round: round, Phase: phase}, nil
+}
+
+// GetProposer retrieves the proposer of the given round at the height following
+// the current head.
+func (api *API) GetProposer(round uint64) (common.Address, error) {
+	head := api.chain.CurrentHeader()
+
+	var lastProposer common.Address
+	if head.Number.Sign() > 0 {
+		var err error
+		if lastProposer, err = api.hotstuff.Author(head); err != nil {
+			return common.Address{}, err
+		}
+	}
+	valSet := api.hotstuff.getValidators(api.chain, head.Number.Uint64(), head.Hash())
+	if valSet.Size() == 0 {
+		return common.Address{}, errUnknownSnapshot
+	}
+	valSet.CalcProposer(lastProposer, round)
+	return valSet.GetProposer().Address(), nil
+}
+
+// GetQuorumCertificate retrieves the quorum certificate sealed into the specified block.
+func (api *API) GetQuorumCertificate(number *rpc.BlockNumber) (*QuorumCert, error) {
+	header, err := api.header(number)
+	if err != nil {
+		return nil, err
+	}
+	if header.Number.Sign() == 0 {
+		return nil, errUnknownBlock
+	}
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, errInvalidExtraDataFormat
+	}
+	proposer, err := api.hotstuff.Author(header)
+	if err != nil {
+		return nil, err
+	}
+	participants, err := api.hotstuff.signers(api.chain, header)
+	if err != nil {
+		return nil, err
+	}
+	return &QuorumCert{
+		Number:         header.Number.Uint64(),
+		Hash:           header.Hash(),
+		Proposer:       proposer,
+		LeaderSeal:     extra.LeaderSeal,
+		AggregatedSeal: extra.AggregatedValidatorsSeal,
+		Bitmap:         hexutil.Bytes(extra.Participants),
+		Participants:   participants,
+	}, nil
+}
+
+// GetSigners retrieves the validators whose seals are aggregated in the specified block.
+func (api *API) GetSigners(number *rpc.BlockNumber) ([]common.Address, error) {
+	header, err := api.header(number)
+	if err != nil {
+		return nil, err
+	}
+	if header.Number.Sign() == 0 {
+		return nil, errUnknownBlock
+	}
+	return api.hotstuff.signers(api.chain, header)
+}
+
+// Status returns the number of blocks proposed and sealed by every validator
+// between the given blocks, the last 64 blocks by default.
+func (api *API) Status(startBlock, endBlock *rpc.BlockNumber) (*status, error) {
+	var (
+		numBlocks = uint64(64)
+		header    = api.chain.CurrentHeader()
+		end       = header.Number.Uint64()
+		start     uint64
+	)
+	if endBlock != nil && *endBlock != rpc.LatestBlockNumber {
+		if *endBlock < 0 || uint64(*endBlock) > end {
+			return nil, fmt.Errorf("end block %d beyond the current head %d", *endBlock, end)
+		}
+		end = uint64(*endBlock)
+	}
+	if startBlock != nil {
+		if *startBlock < 0 {
+			return nil, fmt.Errorf("invalid start block %d", *startBlock)
+		}
+		start = uint64(*startBlock)
+	} else if end > numBlocks {
+		start = end - numBlocks + 1
+	}
+	if start == 0 {
+		start = 1
+	}
+	if start > end {
+		return nil, fmt.Errorf("start block %d beyond the end block %d", start, end)
+	}
+	snap, err := api.hotstuff.snapshot(api.chain, end, api.chain.GetHeaderByNumber(end).Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &status{
+		Proposals:     make(map[common.Address]int),
+		Participation: make(map[common.Address]int),
+		NumBlocks:     end - start + 1,
+	}
+	for _, val := range snap.Validators {
+		res.Proposals[val] = 0
+		res.Participation[val] = 0
+	}
+	for n := start; n <= end; n++ {
+		h := api.chain.GetHeaderByNumber(n)
+		if h == nil {
+			return nil, fmt.Errorf("missing block %d", n)
+		}
+		proposer, err := api.hotstuff.Author(h)
+		if err != nil {
+			return nil, err
+		}
+		res.Proposals[proposer]++
+
+		signers, err := api.hotstuff.signers(api.chain, h)
+		if err != nil {
+			return nil, err
+		}
+		for _, signer := range signers {
+			res.Participation[signer]++
+		}
+	}
+	return res, nil
+}
+
 // Proposals returns the current proposals the node tries to uphold and vote on.
-func (api *API) TestApi() string {
-	return "testApi of hotstuff"
+func (api *API) Proposals() map[common.Address]bool {
+	return api.hotstuff.Proposals()
+}
+
+// Propose injects a new authorization proposal that the validator will attempt to
+// push through. Adding a validator requires its BLS public key, and the proof of
+// possession of the key bound to its address.
+func (api *API) Propose(address common.Address, publicKey hexutil.Bytes, proof hexutil.Bytes, auth bool) error {
+	return api.hotstuff.Propose(address, publicKey, proof, auth)
+}
+
+// Discard drops a currently running proposal, stopping the validator from casting
+// further votes (either for or against).
+func (api *API) Discard(address common.Address) {
+	api.hotstuff.Discard(address)
+}
+
+// header retrieves the requested header, or the current one if none requested.
+func (api *API) header(number *rpc.BlockNumber) (*types.Header, error) {
+	var header *types.Header
+	if number == nil || *number == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
+	}
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return header, nil
 }
@@ -1,10 +1,69 @@
 package engine
 
 import (
+	"time"
+
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	event2 "github.com/ethereum/go-ethereum/consensus/hotstuff/event"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	core2 "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const (
+	// hotstuffMsg is the eth protocol message code carrying consensus messages,
+	// it must match eth.HotstuffMsg.
+	hotstuffMsg = 0x11
+
+	inmemoryPeers    = 40
+	inmemoryMessages = 1024
+	inmemoryExecuted = 16 // Number of executed proposals to keep, each one pins a state in memory
 )
 
+// chainHeadReader is implemented by chains which notify about new heads
+type chainHeadReader interface {
+	SubscribeChainHeadEvent(ch chan<- core2.ChainHeadEvent) event.Subscription
+}
+
+// blockExecutor is implemented by chains which are able to execute and import
+// proposals, such as core.BlockChain
+type blockExecutor interface {
+	consensus.ChainReader
+	InsertChain(chain types.Blocks) (int, error)
+	StateAt(root common.Hash) (*state.StateDB, error)
+	Processor() core2.Processor
+	Validator() core2.Validator
+	GetVMConfig() *vm.Config
+	WriteBlockWithState(block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, emitHeadEvent bool) (core2.WriteStatus, error)
+	WriteBlockWithoutHead(block *types.Block, receipts []*types.Receipt, state *state.StateDB) error
+}
+
+// chainFinalizer is implemented by chains which make the committed blocks canonical
+// and track the blocks finalized by the consensus engine, such as core.BlockChain
+type chainFinalizer interface {
+	SetCanonical(block *types.Block) error
+	SetFinalized(block *types.Block) error
+}
+
+// executedProposal is the result of executing a proposal during its validation,
+// which is written along the block once committed instead of executing it again.
+type executedProposal struct {
+	state    *state.StateDB
+	receipts types.Receipts
+	logs     []*types.Log
+}
+
 // Address returns the owner's address
 func (e *HotStuffEngine) Address() common.Address {
 	return e.signer.EthSigner.Address()
@@ -15,100 +74,564 @@ func (e *HotStuffEngine) EventMux() *event.TypeMux {
 	return e.eventMux
 }
 
-//
-//// Broadcast sends a message to all validators (include self)
-//func (e *HotStuffEngine) Broadcast(valSet ValidatorSet, payload []byte) error {
-//	// send to others
-//	if err := e.Gossip(valSet, payload); err != nil {
-//		return err
-//	}
-//	// send to self
-//	msg := event2.MessageEvent{
-//		Payload: payload,
-//	}
-//	go e.EventMux().Post(msg)
-//	return nil
-//}
-//
-//// Gossip sends a message to all validators (exclude self)
-//func (e *HotStuffEngine) Gossip(valSet ValidatorSet, payload []byte) error {
-//	hash := hotstuff.RLPHash(payload)
-//	e.knownMessages.Add(hash, true)
-//
-//	targets := make(map[common.Address]bool)
-//	for _, val := range valSet.List() { // hotstuff/validator/default.go - defaultValidator
-//		if val.Address() != e.Address() {
-//			targets[val.Address()] = true
-//		}
-//	}
-//	if e.broadcaster != nil && len(targets) > 0 {
-//		ps := e.broadcaster.FindPeers(targets)
-//		for addr, p := range ps {
-//			ms, ok := e.recentMessages.Get(addr)
-//			var m *lru.ARCCache
-//			if ok {
-//				m, _ = ms.(*lru.ARCCache)
-//				if _, k := m.Get(hash); k {
-//					// This peer had this event, skip it
-//					continue
-//				}
-//			} else {
-//				m, _ = lru.NewARC(inmemoryMessages)
-//			}
-//
-//			m.Add(hash, true)
-//			e.recentMessages.Add(addr, m)
-//			go p.Send(hotstuffMsg, payload)
-//		}
-//	}
-//	return nil
-//
-//}
-//
-//// Unicast send a message to single peer
-//func (e *HotStuffEngine) Unicast(valSet ValidatorSet, payload []byte) error {
-//
-//}
-//
-//// PreCommit write seal to header and assemble new qc
-//func (e *HotStuffEngine) PreCommit(proposal Proposal, seals [][]byte) (Proposal, error) {
-//
-//}
-//
-//// ForwardCommit assemble unsealed block and sealed extra into an new full block
-//func (e *HotStuffEngine) ForwardCommit(proposal Proposal, extra []byte) (Proposal, error) {
-//
-//}
-//
-//// Commit delivers an approved proposal to backend.
-//// The delivered proposal will be put into blockchain.
-//func (e *HotStuffEngine) Commit(proposal Proposal) error {
-//
-//}
-//
-//// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
-//// the time difference of the proposal and current time is also returned.
-//func (e *HotStuffEngine) Verify(Proposal) (time.Duration, error) {
-//
-//}
-//
-//// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
-//// the time difference of the proposal and current time is also returned.
-//func (e *HotStuffEngine) VerifyUnsealedProposal(Proposal) (time.Duration, error) {
-//
-//}
-//
-//// LastProposal retrieves latest committed proposal and the address of proposer
-//func (e *HotStuffEngine) LastProposal() (Proposal, common.Address) {
-//
-//}
-//
-//// HasBadBlock returns whether the block with the hash is a bad block
-//func (e *HotStuffEngine) HasBadProposal(hash common.Hash) bool {
-//
-//}
-//
-//// ValidateBlock execute block which contained in prepare message, and validate block state
-//func (e *HotStuffEngine) ValidateBlock(block *types.Block) error {
-//
-//}
+// Broadcast sends a message to all validators (include self)
+func (e *HotStuffEngine) Broadcast(valSet interfaces.ValidatorSet, payload []byte) error {
+	// send to others
+	if err := e.Gossip(valSet, payload); err != nil {
+		return err
+	}
+	// send to self
+	msg := event2.MessageEvent{
+		Payload: payload,
+	}
+	go e.EventMux().Post(msg)
+	return nil
+}
+
+// Gossip sends a message to all validators (exclude self)
+func (e *HotStuffEngine) Gossip(valSet interfaces.ValidatorSet, payload []byte) error {
+	hash := crypto.Keccak256Hash(payload)
+	e.knownMessages.Add(hash, true)
+
+	targets := make(map[common.Address]bool)
+	for _, val := range valSet.List() {
+		if val.Address() != e.Address() {
+			targets[val.Address()] = true
+		}
+	}
+	e.send(targets, hash, payload)
+	return nil
+}
+
+// Unicast send a message to the current proposer only
+func (e *HotStuffEngine) Unicast(valSet interfaces.ValidatorSet, payload []byte) error {
+	proposer := valSet.GetProposer()
+	if proposer == nil {
+		return nil
+	}
+	hash := crypto.Keccak256Hash(payload)
+	e.knownMessages.Add(hash, true)
+
+	if proposer.Address() == e.Address() {
+		go e.EventMux().Post(event2.MessageEvent{
+			Payload: payload,
+		})
+		return nil
+	}
+	e.send(map[common.Address]bool{proposer.Address(): true}, hash, payload)
+	return nil
+}
+
+// send delivers the payload to the connected target validators which have not
+// seen it yet. The validators signing with a remote signer run nodes with another
+// key than their validator key, which are resolved from the messages they send.
+// The validators not resolved yet are looked for among the peers not known to
+// run a validator, rather than among all of them.
+func (e *HotStuffEngine) send(targets map[common.Address]bool, hash common.Hash, payload []byte) {
+	if e.broadcaster == nil || len(targets) == 0 {
+		return
+	}
+	nodes := make(map[common.Address]bool, len(targets))
+	for target := range targets {
+		nodes[e.validatorNode(target)] = true
+	}
+	peers := e.broadcaster.FindPeers(nodes)
+	if len(peers) < len(nodes) {
+		for addr, p := range e.broadcaster.Peers() {
+			if _, ok := peers[addr]; !ok && !e.nodeValidators.Contains(addr) {
+				peers[addr] = p
+			}
+		}
+	}
+	for addr, p := range peers {
+		if e.markPeerMessage(addr, hash) {
+			// This peer had this event, skip it
+			continue
+		}
+		go p.Send(hotstuffMsg, payload)
+	}
+}
+
+// validatorNode returns the address of the node the validator runs, which is the
+// validator address itself unless the validator signs with a remote signer.
+func (e *HotStuffEngine) validatorNode(validator common.Address) common.Address {
+	if node, ok := e.validatorNodes.Get(validator); ok {
+		return node.(common.Address)
+	}
+	return validator
+}
+
+// learnValidatorNode records the node a validator runs from a message signed by
+// the validator and received from the node. Consensus messages are not relayed,
+// so the peer sending a message first is the node of its signer.
+func (e *HotStuffEngine) learnValidatorNode(node common.Address, payload []byte) {
+	msg := new(core.Message)
+	if err := rlp.DecodeBytes(payload, msg); err != nil || msg.VerifySignature() != nil {
+		return
+	}
+	if old, ok := e.validatorNodes.Get(msg.Address); ok && old.(common.Address) != node {
+		e.nodeValidators.Remove(old)
+	}
+	e.validatorNodes.Add(msg.Address, node)
+	e.nodeValidators.Add(node, msg.Address)
+}
+
+// markPeerMessage records that the peer knows the message, and reports whether
+// it was already known before.
+func (e *HotStuffEngine) markPeerMessage(addr common.Address, hash common.Hash) bool {
+	var m *lru.ARCCache
+	if ms, ok := e.recentMessages.Get(addr); ok {
+		m, _ = ms.(*lru.ARCCache)
+		if _, k := m.Get(hash); k {
+			return true
+		}
+	} else {
+		m, _ = lru.NewARC(inmemoryMessages)
+		e.recentMessages.Add(addr, m)
+	}
+	m.Add(hash, true)
+	return false
+}
+
+// SetBroadcaster implements consensus.Handler.SetBroadcaster
+func (e *HotStuffEngine) SetBroadcaster(broadcaster consensus.Broadcaster) {
+	e.broadcaster = broadcaster
+}
+
+// HandleMsg implements consensus.Handler.HandleMsg, it posts the consensus message
+// relayed by the peer to the core unless the message is already known.
+func (e *HotStuffEngine) HandleMsg(addr common.Address, payload []byte) error {
+	e.coreMu.RLock()
+	started := e.coreStarted
+	e.coreMu.RUnlock()
+
+	// Nodes which are not running the consensus simply drop the messages
+	// rather than disconnecting the validators sending them.
+	if !started {
+		return nil
+	}
+	hash := crypto.Keccak256Hash(payload)
+
+	// Mark peer's message
+	e.markPeerMessage(addr, hash)
+
+	// Mark self known message
+	if _, ok := e.knownMessages.Get(hash); ok {
+		return nil
+	}
+	e.knownMessages.Add(hash, true)
+	e.learnValidatorNode(addr, payload)
+
+	go e.EventMux().Post(event2.MessageEvent{
+		Payload: payload,
+	})
+	return nil
+}
+
+// PreCommit write the aggregated seal of the participants, and the round of the
+// view they voted in, to header and assemble new qc
+func (e *HotStuffEngine) PreCommit(proposal interfaces.Proposal, round uint64, participants []common.Address, aggregatedSeal []byte) (interfaces.Proposal, error) {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return nil, errInvalidProposal
+	}
+	header := block.Header()
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, errInvalidExtraDataFormat
+	}
+
+	valSet := e.getValidators(e.chain, header.Number.Uint64()-1, header.ParentHash)
+	extra.Participants = types.NewHotstuffBitmap(valSet.Size())
+	for _, addr := range participants {
+		index, val := valSet.GetByAddress(addr)
+		if val == nil {
+			return nil, errUnauthorized
+		}
+		extra.Participants.Set(index)
+	}
+	extra.AggregatedValidatorsSeal = aggregatedSeal
+	extra.Round = round
+
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return nil, err
+	}
+	return e.ForwardCommit(block, payload)
+}
+
+// ForwardCommit assemble unsealed block and sealed extra into an new full block
+func (e *HotStuffEngine) ForwardCommit(proposal interfaces.Proposal, extra []byte) (interfaces.Proposal, error) {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return nil, errInvalidProposal
+	}
+	header := block.Header()
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity:types.HotstuffExtraVanity], extra...)
+	return block.WithSeal(header), nil
+}
+
+// Certify delivers a proposal certified in the chained mode to backend, which
+// writes it to the chain without making it canonical and extends it with the next
+// proposals. It is made canonical and finalized later on, once committed.
+func (e *HotStuffEngine) Certify(proposal interfaces.Proposal) error {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return errInvalidProposal
+	}
+	e.logger.Debug("Certified", "address", e.Address(), "hash", block.Hash(), "number", block.Number().Uint64())
+	if err := e.writeCertified(block); err != nil {
+		return err
+	}
+	e.certifiedMu.Lock()
+	e.certified = block
+	rawdb.WriteCertifiedBlockHash(e.db, block.Hash())
+	e.certifiedMu.Unlock()
+
+	// The chain head doesn't move, so the core and the miner are told directly
+	go e.EventMux().Post(event2.FinalCommittedEvent{Header: block.Header()})
+	e.certifiedFeed.Send(block)
+	return nil
+}
+
+// Commit delivers an approved proposal to backend.
+// The delivered proposal will be put into blockchain, where it is final right
+// away. In the chained mode, the proposal is already in the chain since it was
+// certified, and it is made canonical and finalized along with its ancestors.
+func (e *HotStuffEngine) Commit(proposal interfaces.Proposal) error {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return errInvalidProposal
+	}
+	e.logger.Info("Committed", "address", e.Address(), "hash", block.Hash(), "number", block.Number().Uint64())
+	committedMeter.Mark(1)
+
+	if !e.config.Chained {
+		return e.insert(block)
+	}
+	chain, ok := e.chain.(chainFinalizer)
+	if !ok {
+		return errUnknownBlock
+	}
+	if err := chain.SetCanonical(block); err != nil {
+		return err
+	}
+	return chain.SetFinalized(block)
+}
+
+// insert puts the block into the local chain, handing it to the sealing miner
+// if it is the local proposal.
+func (e *HotStuffEngine) insert(block *types.Block) error {
+	// The committed block only differs from the validated proposal by its seals,
+	// so the result of the validation, if still around, is the one of the block.
+	hash := proposalHash(block.Header())
+	executed, _ := e.executed.Get(hash)
+	e.executed.Remove(hash)
+
+	// - if the proposed and committed blocks are the same, send the proposed hash
+	//   to commit channel, which is being watched inside the engine.Seal() function.
+	// - otherwise, write the block with the state of its validation or import it
+	//   into the local chain directly.
+	if e.proposedBlockHash == hash {
+		select {
+		case e.commitCh <- block:
+			return nil
+		default:
+		}
+	}
+	importedMeter.Mark(1)
+	chain, ok := e.chain.(blockExecutor)
+	if !ok {
+		return errUnknownBlock
+	}
+	if executed != nil {
+		err := e.writeExecuted(chain, block, executed.(*executedProposal))
+		if err == nil {
+			reusedMeter.Mark(1)
+			return nil
+		}
+		e.logger.Warn("Failed to write executed proposal, importing", "hash", block.Hash(), "number", block.NumberU64(), "err", err)
+	}
+	_, err := chain.InsertChain(types.Blocks{block})
+	return err
+}
+
+// writeExecuted writes the committed block to the chain with the state and the
+// receipts computed when its proposal was validated.
+func (e *HotStuffEngine) writeExecuted(chain blockExecutor, block *types.Block, executed *executedProposal) error {
+	if chain.GetHeader(block.Hash(), block.NumberU64()) != nil {
+		return nil
+	}
+	if err := e.VerifyHeader(chain, block.Header(), true); err != nil {
+		return err
+	}
+	receipts, logs := sealedReceipts(block, executed)
+	_, err := chain.WriteBlockWithState(block, receipts, logs, executed.state, true)
+	return err
+}
+
+// writeCertified writes the certified block to the chain without making it the
+// head, with the state computed when its proposal was validated if still around.
+func (e *HotStuffEngine) writeCertified(block *types.Block) error {
+	chain, ok := e.chain.(blockExecutor)
+	if !ok {
+		return errUnknownBlock
+	}
+	if chain.GetHeader(block.Hash(), block.NumberU64()) != nil {
+		return nil
+	}
+	if err := e.VerifyHeader(chain, block.Header(), true); err != nil {
+		return err
+	}
+	hash := proposalHash(block.Header())
+	cached, _ := e.executed.Get(hash)
+	e.executed.Remove(hash)
+
+	executed, ok := cached.(*executedProposal)
+	if ok {
+		reusedMeter.Mark(1)
+	} else {
+		var err error
+		if executed, err = e.execute(chain, block); err != nil {
+			return err
+		}
+	}
+	importedMeter.Mark(1)
+	receipts, _ := sealedReceipts(block, executed)
+	return chain.WriteBlockWithoutHead(block, receipts, executed.state)
+}
+
+// sealedReceipts copies the receipts and logs derived for the unsealed proposal,
+// updating the block hash they refer to.
+func sealedReceipts(block *types.Block, executed *executedProposal) ([]*types.Receipt, []*types.Log) {
+	var (
+		hash     = block.Hash()
+		receipts = make([]*types.Receipt, len(executed.receipts))
+		logs     []*types.Log
+	)
+	for i, executedReceipt := range executed.receipts {
+		receipt := new(types.Receipt)
+		receipts[i] = receipt
+		*receipt = *executedReceipt
+		receipt.BlockHash = hash
+
+		receipt.Logs = make([]*types.Log, len(executedReceipt.Logs))
+		for j, executedLog := range executedReceipt.Logs {
+			log := new(types.Log)
+			receipt.Logs[j] = log
+			*log = *executedLog
+			log.BlockHash = hash
+		}
+		logs = append(logs, receipt.Logs...)
+	}
+	return receipts, logs
+}
+
+// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
+// the time difference of the proposal and current time is also returned.
+func (e *HotStuffEngine) Verify(proposal interfaces.Proposal) (time.Duration, error) {
+	return e.verify(proposal, true)
+}
+
+// VerifyUnsealedProposal verifies the proposal without the aggregated seal of the validators.
+// If a consensus.ErrFutureBlock error is returned, the time difference of the proposal and
+// current time is also returned.
+func (e *HotStuffEngine) VerifyUnsealedProposal(proposal interfaces.Proposal) (time.Duration, error) {
+	return e.verify(proposal, false)
+}
+
+func (e *HotStuffEngine) verify(proposal interfaces.Proposal, seal bool) (time.Duration, error) {
+	// Check if the proposal is a valid block
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return 0, errInvalidProposal
+	}
+
+	// check bad block
+	if e.HasBadProposal(block.Hash()) {
+		return 0, core2.ErrBannedHash
+	}
+
+	// check block body
+	txnHash := types.DeriveSha(block.Transactions(), trie.NewStackTrie(nil))
+	if txnHash != block.Header().TxHash {
+		return 0, errMismatchTxhashes
+	}
+	if block.UncleHash() != nilUncleHash {
+		return 0, errInvalidUncleHash
+	}
+
+	// verify the header of proposed block
+	err := e.VerifyHeader(e.chain, block.Header(), seal)
+	if err == consensus.ErrFutureBlock {
+		return time.Unix(int64(block.Time()), 0).Sub(now()), consensus.ErrFutureBlock
+	}
+	return 0, err
+}
+
+// LastProposal retrieves latest committed proposal and the address of proposer.
+// In the chained mode, it is the latest certified proposal.
+func (e *HotStuffEngine) LastProposal() (interfaces.Proposal, common.Address) {
+	block := e.CertifiedHead(e.currentBlock())
+
+	var proposer common.Address
+	if block.Number().Cmp(common.Big0) > 0 {
+		var err error
+		if proposer, err = e.Author(block.Header()); err != nil {
+			e.logger.Error("Failed to get block proposer", "err", err)
+		}
+	}
+	return block, proposer
+}
+
+// CertifiedHead returns the block the next proposal extends: in the chained mode,
+// the latest certified block as long as it descends from the given canonical head,
+// otherwise the head itself.
+func (e *HotStuffEngine) CertifiedHead(head *types.Block) *types.Block {
+	e.certifiedMu.Lock()
+	certified := e.certified
+	e.certifiedMu.Unlock()
+
+	if certified == nil || !e.config.Chained || certified.NumberU64() <= head.NumberU64() {
+		return head
+	}
+	ancestor := certified.Header()
+	for ancestor != nil && ancestor.Number.Uint64() > head.NumberU64() {
+		ancestor = e.chain.GetHeader(ancestor.ParentHash, ancestor.Number.Uint64()-1)
+	}
+	if ancestor == nil || ancestor.Hash() != head.Hash() {
+		return head
+	}
+	return certified
+}
+
+// SubscribeCertifiedHead notifies about the blocks certified in the chained mode,
+// which the miner extends before they are committed to the canonical chain.
+func (e *HotStuffEngine) SubscribeCertifiedHead(ch chan<- *types.Block) event.Subscription {
+	return e.certifiedFeed.Subscribe(ch)
+}
+
+// loadCertified restores the latest certified block, which may still be waiting
+// for the three-chain committing it after a restart.
+func (e *HotStuffEngine) loadCertified() {
+	hash := rawdb.ReadCertifiedBlockHash(e.db)
+	number := rawdb.ReadHeaderNumber(e.db, hash)
+	if number == nil {
+		return
+	}
+	if block := e.chain.GetBlock(hash, *number); block != nil {
+		e.certifiedMu.Lock()
+		e.certified = block
+		e.certifiedMu.Unlock()
+	}
+}
+
+// HasBadProposal returns whether the block with the hash is a bad block
+func (e *HotStuffEngine) HasBadProposal(hash common.Hash) bool {
+	return core2.BadHashes[hash]
+}
+
+// ValidateBlock execute block which contained in prepare message, and validate block state.
+// The resulting state is kept to write the block once certified or committed.
+func (e *HotStuffEngine) ValidateBlock(block *types.Block) error {
+	chain, ok := e.chain.(blockExecutor)
+	if !ok {
+		return errUnknownBlock
+	}
+	executed, err := e.execute(chain, block)
+	if err != nil {
+		return err
+	}
+	// Keep the result around, the block is written with it once committed
+	e.executed.Add(proposalHash(block.Header()), executed)
+	return nil
+}
+
+// execute processes the block on top of the state of its parent, and validates
+// the resulting state.
+func (e *HotStuffEngine) execute(chain blockExecutor, block *types.Block) (*executedProposal, error) {
+	parent := chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+	if parent == nil {
+		return nil, consensus.ErrUnknownAncestor
+	}
+	statedb, err := chain.StateAt(parent.Root())
+	if err != nil {
+		return nil, err
+	}
+	receipts, logs, usedGas, err := chain.Processor().Process(block, statedb, *chain.GetVMConfig())
+	if err != nil {
+		return nil, err
+	}
+	if err := chain.Validator().ValidateState(block, statedb, receipts, usedGas); err != nil {
+		return nil, err
+	}
+	return &executedProposal{
+		state:    statedb,
+		receipts: receipts,
+		logs:     logs,
+	}, nil
+}
+
+// Validators returns the validator set which is responsible for the block at the given height
+func (e *HotStuffEngine) Validators(number uint64) interfaces.ValidatorSet {
+	var parent *types.Header
+	if number > 0 && e.chain != nil {
+		parent = e.headerByNumber(number - 1)
+	}
+	if parent == nil {
+		return validator.NewSet(nil, e.policy(e.chain))
+	}
+	return e.getValidators(e.chain, parent.Number.Uint64(), parent.Hash())
+}
+
+// headerByNumber retrieves the header at the given height on the branch of the
+// last proposal, which runs through the certified blocks past the canonical head
+// in the chained mode.
+func (e *HotStuffEngine) headerByNumber(number uint64) *types.Header {
+	if e.currentBlock == nil {
+		return e.chain.GetHeaderByNumber(number)
+	}
+	head := e.currentBlock()
+	if number <= head.NumberU64() {
+		return e.chain.GetHeaderByNumber(number)
+	}
+	header := e.CertifiedHead(head).Header()
+	for header != nil && header.Number.Uint64() > number {
+		header = e.chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	}
+	if header == nil || header.Number.Uint64() != number {
+		return nil
+	}
+	return header
+}
+
+// NextValidators returns the validator set which is responsible for the child of
+// the given proposal, applying its header on top of the snapshot of its parent.
+func (e *HotStuffEngine) NextValidators(proposal interfaces.Proposal) interfaces.ValidatorSet {
+	block, ok := proposal.(*types.Block)
+	if !ok || e.chain == nil || block.NumberU64() == 0 {
+		return validator.NewSet(nil, e.policy(e.chain))
+	}
+	header := block.Header()
+	snap, err := e.snapshot(e.chain, header.Number.Uint64(), header.Hash(), []*types.Header{header})
+	if err != nil {
+		e.logger.Warn("Failed to retrieve validator snapshot", "number", header.Number, "hash", header.Hash(), "err", err)
+		return validator.NewSet(nil, e.policy(e.chain))
+	}
+	valSet := snap.ValSet(e.policy(e.chain))
+	if valSet.Policy() == interfaces.VRF {
+		valSet.SetSeed(vrfSeed(header))
+	}
+	return valSet
+}
+
+// proposalHash returns the hash identifying the proposal the header was built
+// from, that is without the seals and the salt the proposer stamps the proposal
+// with in the round it proposes it.
+func proposalHash(header *types.Header) common.Hash {
+	header = types.HotstuffFilteredHeader(header, false)
+	if err := fillSalt(header, []byte{}); err != nil {
+		return common.Hash{}
+	}
+	return header.Hash()
+}
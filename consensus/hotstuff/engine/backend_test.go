@@ -0,0 +1,284 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	core2 "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// newTestChain creates a chain driven by an engine of the single validator of the
+// genesis, in the chained mode or not.
+func newTestChain(t *testing.T, genesis *core2.Genesis, key *ecdsa.PrivateKey, sk blscommon.SecretKey, chained bool) (*HotStuffEngine, *core2.BlockChain) {
+	db := rawdb.NewMemoryDatabase()
+	genesis.MustCommit(db)
+
+	conf := config.FromChainConfig(genesis.Config.HotStuff)
+	conf.Chained = chained
+	e := New(key, &sk, conf, db).(*HotStuffEngine)
+	chain, err := core2.NewBlockChain(db, nil, genesis.Config, e, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	e.chain = chain
+	e.currentBlock = chain.CurrentBlock
+	return e, chain
+}
+
+// newTestProposal assembles a proposal with the given transactions on top of the
+// parent, as the miner would.
+func newTestProposal(t *testing.T, e *HotStuffEngine, chain *core2.BlockChain, parent *types.Block, txs types.Transactions) *types.Block {
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     new(big.Int).Add(parent.Number(), common.Big1),
+		GasLimit:   parent.GasLimit(),
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+	}
+	assert.NoError(t, e.Prepare(chain, header))
+
+	statedb, _ := chain.StateAt(parent.Root())
+	gasPool := new(core2.GasPool).AddGas(header.GasLimit)
+	receipts := make(types.Receipts, len(txs))
+	for i, tx := range txs {
+		statedb.Prepare(tx.Hash(), i)
+		receipt, err := core2.ApplyTransaction(chain.Config(), chain, &header.Coinbase, gasPool, statedb, header, tx, &header.GasUsed, vm.Config{})
+		if err != nil {
+			t.Fatalf("failed to apply transaction: %v", err)
+		}
+		receipts[i] = receipt
+	}
+	block, err := e.FinalizeAndAssemble(chain, header, statedb, txs, nil, receipts)
+	if err != nil {
+		t.Fatalf("failed to assemble block: %v", err)
+	}
+	header = block.Header()
+	assert.NoError(t, e.signer.EthSigner.SealBeforeCommit(header))
+	return block.WithSeal(header)
+}
+
+// sealTestProposal seals the proposal with the quorum certificate of the single
+// validator.
+func sealTestProposal(t *testing.T, e *HotStuffEngine, proposal *types.Block, sk blscommon.SecretKey) *types.Block {
+	chained := e.config.Chained
+	digest := core.SealDigest(proposal.Header(), 0, chained)
+	seal := blst.AggregateSignatures([]blscommon.Signature{sk.Sign(digest.Bytes())}).Marshal()
+	sealed, err := e.PreCommit(proposal, 0, []common.Address{e.Address()}, seal)
+	if err != nil {
+		t.Fatalf("failed to seal proposal: %v", err)
+	}
+	return sealed.(*types.Block)
+}
+
+// Tests that committed blocks are written with the state computed when their
+// proposal was validated, instead of being executed again.
+func TestCommitExecuted(t *testing.T) {
+	var (
+		key, _   = crypto.GenerateKey()
+		sk, _    = blst.RandKey()
+		addr     = crypto.PubkeyToAddress(key.PublicKey)
+		contract = common.Address{0xaa}
+		genesis  = core2.DeveloperHotstuffGenesisBlock(1, addr, sk.PublicKey().Marshal(), addr)
+	)
+	genesis.Alloc[contract] = core2.GenesisAccount{
+		Code:    []byte{0x60, 0x00, 0x60, 0x00, 0xa0}, // LOG0(0, 0)
+		Balance: new(big.Int),
+	}
+	e, chain := newTestChain(t, genesis, key, sk, false)
+	defer chain.Stop()
+
+	// Assemble a proposal emitting a log, as the miner would
+	signer := types.LatestSigner(genesis.Config)
+	tx, _ := types.SignTx(types.NewTransaction(0, contract, nil, 100000, big.NewInt(params.InitialBaseFee), nil), signer, key)
+	proposal := newTestProposal(t, e, chain, chain.CurrentBlock(), types.Transactions{tx})
+
+	// Validating the proposal executes it and keeps the result
+	assert.NoError(t, e.ValidateBlock(proposal))
+	assert.Equal(t, 1, e.executed.Len())
+
+	// Commit the proposal with the quorum certificate of the single validator
+	committed := sealTestProposal(t, e, proposal, sk)
+	assert.NoError(t, e.Commit(committed))
+	assert.Equal(t, 0, e.executed.Len())
+
+	// The block is written with receipts and logs pointing to the sealed block
+	if head := chain.CurrentBlock(); head.Hash() != committed.Hash() {
+		t.Fatalf("head mismatch: have #%d [%x], want #%d [%x]", head.NumberU64(), head.Hash(), committed.NumberU64(), committed.Hash())
+	}
+	receipts := chain.GetReceiptsByHash(committed.Hash())
+	if len(receipts) != 1 || len(receipts[0].Logs) != 1 {
+		t.Fatalf("receipts mismatch: have %v", receipts)
+	}
+	assert.Equal(t, committed.Hash(), receipts[0].BlockHash)
+	assert.Equal(t, committed.Hash(), receipts[0].Logs[0].BlockHash)
+	if _, err := chain.StateAt(committed.Root()); err != nil {
+		t.Fatalf("committed state missing: %v", err)
+	}
+}
+
+// Tests that the blocks certified in the chained mode are kept off the canonical
+// chain while the next proposals extend them, until they are committed.
+func TestCertifyChained(t *testing.T) {
+	var (
+		key, _  = crypto.GenerateKey()
+		sk, _   = blst.RandKey()
+		addr    = crypto.PubkeyToAddress(key.PublicKey)
+		genesis = core2.DeveloperHotstuffGenesisBlock(1, addr, sk.PublicKey().Marshal(), addr)
+	)
+	e, chain := newTestChain(t, genesis, key, sk, true)
+	defer chain.Stop()
+
+	certifiedCh := make(chan *types.Block, 2)
+	sub := e.SubscribeCertifiedHead(certifiedCh)
+	defer sub.Unsubscribe()
+
+	// Certify two blocks, the first one executed during its validation
+	genesisBlock := chain.CurrentBlock()
+	proposal := newTestProposal(t, e, chain, genesisBlock, nil)
+	assert.NoError(t, e.ValidateBlock(proposal))
+	first := sealTestProposal(t, e, proposal, sk)
+	assert.NoError(t, e.Certify(first))
+	assert.Equal(t, 0, e.executed.Len())
+
+	second := sealTestProposal(t, e, newTestProposal(t, e, chain, first, nil), sk)
+	assert.NoError(t, e.Certify(second))
+
+	// The certified blocks are written, but the canonical chain doesn't move
+	if head := chain.CurrentBlock(); head.Hash() != genesisBlock.Hash() {
+		t.Fatalf("certified block made canonical: #%d [%x]", head.NumberU64(), head.Hash())
+	}
+	assert.True(t, chain.HasBlockAndState(second.Hash(), second.NumberU64()))
+	assert.Equal(t, common.Hash{}, chain.GetCanonicalHash(1))
+	assert.Equal(t, first.Hash(), (<-certifiedCh).Hash())
+	assert.Equal(t, second.Hash(), (<-certifiedCh).Hash())
+
+	// The next proposal extends the latest certified block
+	last, _ := e.LastProposal()
+	assert.Equal(t, second.Hash(), last.Hash())
+	assert.Equal(t, second.Hash(), e.CertifiedHead(genesisBlock).Hash())
+	assert.Equal(t, first.Hash(), e.headerByNumber(1).Hash())
+
+	// Committing the second block makes both canonical and final
+	assert.NoError(t, e.Commit(second))
+	if head := chain.CurrentBlock(); head.Hash() != second.Hash() {
+		t.Fatalf("head mismatch: have #%d [%x], want #%d [%x]", head.NumberU64(), head.Hash(), second.NumberU64(), second.Hash())
+	}
+	assert.Equal(t, first.Hash(), chain.GetCanonicalHash(1))
+	assert.Equal(t, second.Hash(), chain.CurrentFinalizedBlock().Hash())
+
+	// The certified block is restored after a restart
+	restarted := New(key, &sk, e.config, e.db).(*HotStuffEngine)
+	restarted.chain = chain
+	restarted.loadCertified()
+	assert.Equal(t, second.Hash(), restarted.CertifiedHead(genesisBlock).Hash())
+}
+
+type testPeer struct {
+	addr common.Address
+	sent chan common.Address
+}
+
+func (p *testPeer) Send(msgcode uint64, data interface{}) error {
+	p.sent <- p.addr
+	return nil
+}
+
+type testBroadcaster map[common.Address]consensus.Peer
+
+func (b testBroadcaster) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	peers := make(map[common.Address]consensus.Peer)
+	for addr := range targets {
+		if p, ok := b[addr]; ok {
+			peers[addr] = p
+		}
+	}
+	return peers
+}
+
+func (b testBroadcaster) Peers() map[common.Address]consensus.Peer {
+	peers := make(map[common.Address]consensus.Peer)
+	for addr, p := range b {
+		peers[addr] = p
+	}
+	return peers
+}
+
+// Tests that messages to validators signing remotely go to the nodes they were
+// learned to run, and that the unresolved validators are only looked for among
+// the peers not known to run another validator.
+func TestSendRemoteValidators(t *testing.T) {
+	var (
+		key, _    = crypto.GenerateKey()
+		sk, _     = blst.RandKey()
+		remote, _ = crypto.GenerateKey() // validator key of a node signing remotely
+		validator = crypto.PubkeyToAddress(remote.PublicKey)
+		node      = common.Address{0x01} // node of the remote validator
+		direct    = common.Address{0x02} // validator running its node with its key
+		other     = common.Address{0x03} // peer not running a validator
+		sent      = make(chan common.Address, 16)
+	)
+	e := New(key, &sk, config.DefaultBasicConfig, rawdb.NewMemoryDatabase()).(*HotStuffEngine)
+	e.coreStarted = true
+
+	peers := make(testBroadcaster)
+	for _, addr := range []common.Address{node, direct, other} {
+		peers[addr] = &testPeer{addr: addr, sent: sent}
+	}
+	e.SetBroadcaster(peers)
+
+	recipients := func(payload []byte) map[common.Address]bool {
+		e.send(map[common.Address]bool{validator: true, direct: true}, crypto.Keccak256Hash(payload), payload)
+		got := make(map[common.Address]bool)
+		for {
+			select {
+			case addr := <-sent:
+				got[addr] = true
+			case <-time.After(100 * time.Millisecond):
+				return got
+			}
+		}
+	}
+	// Unresolved, the remote validator is looked for among the other peers
+	assert.Equal(t, map[common.Address]bool{node: true, direct: true, other: true}, recipients([]byte{0x01}))
+
+	// Learn the node of the remote validator from a message it sends
+	msg := &core.Message{Code: core.MsgTypeNewView, View: &core.View{Height: common.Big1, Round: common.Big0}, Address: validator}
+	data, _ := msg.PayloadNoSig()
+	msg.Signature, _ = crypto.Sign(crypto.Keccak256(data), remote)
+	payload, _ := rlp.EncodeToBytes(msg)
+	assert.NoError(t, e.HandleMsg(node, payload))
+
+	assert.Equal(t, map[common.Address]bool{node: true, direct: true}, recipients([]byte{0x02}))
+}
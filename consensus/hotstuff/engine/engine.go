@@ -20,6 +20,7 @@ package engine
 
 import (
 	"crypto/ecdsa"
+	"fmt"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
@@ -27,15 +28,20 @@ import (
 	event2 "github.com/ethereum/go-ethereum/consensus/hotstuff/event"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	core2 "github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/state"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/ethereum/go-ethereum/trie"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
 	common2 "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
 	"math/big"
+	"runtime"
 	"sync"
 	"time"
 )
@@ -48,12 +54,19 @@ var (
 	now               = time.Now
 )
 
+const (
+	checkpointInterval = 1024  // Number of blocks after which to save the snapshot to the database
+	inmemorySnapshots  = 128   // Number of recent snapshots to keep in memory
+	defaultEpoch       = 30000 // Default number of blocks after which to checkpoint the validator set
+)
+
 type HotStuffEngine struct {
-	signer          *core.Signer
-	validatorNo     int
-	amountValidator int
-	logger          log.Logger
-	config          *config.Config
+	signer *core.Signer
+	logger log.Logger
+	config *config.Config
+
+	db      ethdb.Database // Database to store and retrieve snapshot checkpoints
+	recents *lru.ARCCache  // Snapshots for recent block to speed up reorgs
 
 	sealMu sync.Mutex
 	coreMu sync.RWMutex
@@ -69,16 +82,73 @@ type HotStuffEngine struct {
 	getBlockByHash func(hash common.Hash) *types.Block
 
 	eventMux *event.TypeMux
+	headSub  event.Subscription
+
+	broadcaster    consensus.Broadcaster
+	recentMessages *lru.ARCCache // the cache of peer's messages
+	knownMessages  *lru.ARCCache // the cache of self messages
+	validatorNodes *lru.ARCCache // node addresses of the validators, learned from their messages
+	nodeValidators *lru.ARCCache // validator addresses of the nodes, the reverse of validatorNodes
+
+	proposals   map[common.Address]*types.HotstuffVote // Current list of proposals we are pushing
+	proposalsMu sync.RWMutex                           // Protects the proposals
+
+	evidence   map[common.Hash]*types.HotstuffEvidence // Verified equivocations waiting to be included in a block
+	evidenceMu sync.RWMutex                            // Protects the evidence
+
+	executed *lru.ARCCache // Results of the proposals executed during validation, keyed by proposal hash
+
+	certified     *types.Block // Latest block certified in the chained mode, ahead of the canonical head until committed
+	certifiedMu   sync.Mutex   // Protects the certified block
+	certifiedFeed event.Feed   // Notifies the miner about the certified blocks to extend
 }
 
 func New(privateKey *ecdsa.PrivateKey, consensusKey *common2.SecretKey, config *config.Config, db ethdb.Database) consensus.Hotstuff {
-	return &HotStuffEngine{
-		signer: &core.Signer{
-			EthSigner: core.NewEthSigner(privateKey, db),
-			BlsSigner: core.NewBlsSigner(consensusKey, db),
-		},
-		config: config,
+	signer := &core.Signer{
+		EthSigner: core.NewEthSigner(privateKey, db),
+		BlsSigner: core.NewBlsSigner(consensusKey, db),
 	}
+	return newEngine(signer, config, db)
+}
+
+// NewWithRemoteSigner creates an engine signing with the keys held by the remote
+// signer, such as clef, instead of holding them in process.
+func NewWithRemoteSigner(remote core.RemoteSigner, config *config.Config, db ethdb.Database) (consensus.Hotstuff, error) {
+	blsSigner, err := core.NewRemoteBlsSigner(remote, db)
+	if err != nil {
+		return nil, fmt.Errorf("invalid remote consensus key: %v", err)
+	}
+	signer := &core.Signer{
+		EthSigner: core.NewRemoteEthSigner(remote, db),
+		BlsSigner: blsSigner,
+	}
+	return newEngine(signer, config, db), nil
+}
+
+func newEngine(signer *core.Signer, config *config.Config, db ethdb.Database) *HotStuffEngine {
+	recents, _ := lru.NewARC(inmemorySnapshots)
+	recentMessages, _ := lru.NewARC(inmemoryPeers)
+	knownMessages, _ := lru.NewARC(inmemoryMessages)
+	validatorNodes, _ := lru.NewARC(inmemoryPeers)
+	nodeValidators, _ := lru.NewARC(inmemoryPeers)
+	executed, _ := lru.NewARC(inmemoryExecuted)
+	engine := &HotStuffEngine{
+		signer:         signer,
+		logger:         log.New("address", signer.EthSigner.Address()),
+		config:         config,
+		db:             db,
+		recents:        recents,
+		eventMux:       new(event.TypeMux),
+		recentMessages: recentMessages,
+		knownMessages:  knownMessages,
+		validatorNodes: validatorNodes,
+		nodeValidators: nodeValidators,
+		proposals:      make(map[common.Address]*types.HotstuffVote),
+		evidence:       make(map[common.Hash]*types.HotstuffEvidence),
+		executed:       executed,
+	}
+	engine.core = core.New(engine, config, signer, db)
+	return engine
 }
 
 func (e *HotStuffEngine) Author(header *types.Header) (common.Address, error) {
@@ -89,27 +159,100 @@ func (e *HotStuffEngine) VerifyHeader(chain consensus.ChainHeaderReader, header
 	return e.verifyHeader(chain, header, nil, seal)
 }
 
+// VerifyHeaders checks the headers in order on a single thread, as each one
+// depends on the validator snapshot of its parent, while the aggregated seals,
+// where the time goes, are batch verified across as many workers as CPUs.
 func (e *HotStuffEngine) VerifyHeaders(chain consensus.ChainHeaderReader, headers []*types.Header, seals []bool) (chan<- struct{}, <-chan error) {
-	abort := make(chan struct{})
-	results := make(chan error, len(headers))
+	var (
+		abort   = make(chan struct{})
+		results = make(chan error, len(headers))
+		batches = make(chan *sealBatch, len(headers)/sealBatchSize+1)
+		pending = make(chan *sealBatch)
+	)
+	workers := runtime.NumCPU()
+	if limit := len(headers)/sealBatchSize + 1; workers > limit {
+		workers = limit
+	}
+	for i := 0; i < workers; i++ {
+		go func() {
+			for batch := range pending {
+				for j, err := range core.VerifyAggregatedSeals(batch.seals) {
+					batch.errs[batch.indices[j]] = err
+				}
+				close(batch.done)
+			}
+		}()
+	}
+	// Check the headers, handing their seals over to the workers batch by batch
 	go func() {
+		defer close(pending)
+		defer close(batches)
+
+		batch := newSealBatch()
 		for i, header := range headers {
 			seal := false
 			if seals != nil && len(seals) > i {
 				seal = seals[i]
 			}
-			err := e.verifyHeader(chain, header, headers[:i], seal)
+			aggregated, err := e.verifyUnsealedHeader(chain, header, headers[:i], seal)
+			batch.add(aggregated, err)
 
+			if len(batch.errs) == sealBatchSize || i == len(headers)-1 {
+				batches <- batch
+				select {
+				case <-abort:
+					return
+				case pending <- batch:
+				}
+				batch = newSealBatch()
+			}
+		}
+	}()
+	// Deliver the results in order once their batch is verified
+	go func() {
+		for batch := range batches {
 			select {
 			case <-abort:
 				return
-			case results <- err:
+			case <-batch.done:
+			}
+			for _, err := range batch.errs {
+				select {
+				case <-abort:
+					return
+				case results <- err:
+				}
 			}
 		}
 	}()
 	return abort, results
 }
 
+// sealBatchSize is the number of headers whose seals are verified at once.
+const sealBatchSize = 32
+
+// sealBatch is a run of consecutive headers, whose aggregated seals are batch
+// verified by a worker.
+type sealBatch struct {
+	errs    []error                // Verification result of every header of the batch
+	seals   []*core.AggregatedSeal // Seals left to verify
+	indices []int                  // Index in the batch of the header of every seal
+	done    chan struct{}          // Closed once the seals are verified
+}
+
+func newSealBatch() *sealBatch {
+	return &sealBatch{done: make(chan struct{})}
+}
+
+// add appends the header to the batch, along its seal to verify, if any.
+func (b *sealBatch) add(seal *core.AggregatedSeal, err error) {
+	if seal != nil {
+		b.seals = append(b.seals, seal)
+		b.indices = append(b.indices, len(b.errs))
+	}
+	b.errs = append(b.errs, err)
+}
+
 // // VerifyUncles verifies that the given block's uncles conform to the consensus
 // // rules of a given engine.
 func (e *HotStuffEngine) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
@@ -133,6 +276,48 @@ func (e *HotStuffEngine) Prepare(chain consensus.ChainHeaderReader, header *type
 	// use the same difficulty for all blocks
 	header.Difficulty = defaultDifficulty
 
+	// the validators of the parent block keep sealing the new one, and only the
+	// checkpoint blocks record the validators of the next epoch
+	snap, err := e.snapshot(chain, parent.Number.Uint64(), parent.Hash(), nil)
+	if err != nil {
+		return err
+	}
+	var (
+		vals     []common.Address
+		keys     [][]byte
+		vote     *types.HotstuffVote
+		evidence []*types.HotstuffEvidence
+	)
+	if header.Number.Uint64()%snap.Epoch == 0 {
+		if vals, keys, err = e.checkpointValidators(chain, parent, snap); err != nil {
+			return err
+		}
+	} else {
+		// the validators elected by stake are not voted in
+		if !snap.Staking {
+			vote = e.pickVote(snap)
+		}
+		evidence = e.pickEvidence(header.Number.Uint64(), snap)
+	}
+	if err := types.HotstuffHeaderFillWithValidators(header, vals); err != nil {
+		return err
+	}
+	if len(vals) > 0 {
+		if err := fillPublicKeys(header, keys); err != nil {
+			return err
+		}
+	}
+	if vote != nil {
+		if err := fillVote(header, vote); err != nil {
+			return err
+		}
+	}
+	if len(evidence) > 0 {
+		if err := fillEvidence(header, evidence); err != nil {
+			return err
+		}
+	}
+
 	// set header's timestamp
 	header.Time = parent.Time + e.config.BlockPeriod
 	if header.Time < uint64(time.Now().Unix()) {
@@ -143,16 +328,20 @@ func (e *HotStuffEngine) Prepare(chain consensus.ChainHeaderReader, header *type
 }
 
 func (e *HotStuffEngine) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
-	uncles []*types.Header) {
+	uncles []*types.Header) error {
+	if err := e.accumulateRewards(chain, state, header); err != nil {
+		return err
+	}
 	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
 	header.UncleHash = nilUncleHash
+	return nil
 }
 
 func (e *HotStuffEngine) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, txs []*types.Transaction,
 	uncles []*types.Header, receipts []*types.Receipt) (*types.Block, error) {
-	// currently no rewards in hotstuff engine
-	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
-	header.UncleHash = nilUncleHash
+	if err := e.Finalize(chain, header, state, txs, uncles); err != nil {
+		return nil, err
+	}
 
 	// Assemble and return the final block for sealing
 	return types.NewBlock(header, txs, nil, receipts, trie.NewStackTrie(nil)), nil
@@ -162,6 +351,11 @@ func (e *HotStuffEngine) Seal(chain consensus.ChainHeaderReader, block *types.Bl
 	// update the block header timestamp and signature and propose the block to core engine
 	header := block.Header()
 
+	// For 0-period chains, refuse to seal empty blocks (no reward but would spin sealing)
+	if e.config.BlockPeriod == 0 && len(block.Transactions()) == 0 {
+		return errWaitTransactions
+	}
+
 	// sign the sig hash and fill extra seal
 	if err := e.signer.EthSigner.SealBeforeCommit(header); err != nil {
 		return err
@@ -171,14 +365,24 @@ func (e *HotStuffEngine) Seal(chain consensus.ChainHeaderReader, block *types.Bl
 	go func() {
 		// get the proposed block hash and clear it if the seal() is completed.
 		e.sealMu.Lock()
-		e.proposedBlockHash = block.Hash()
+		e.proposedBlockHash = proposalHash(block.Header())
 		e.logger.Trace("WorkerSealNewBlock", "hash", block.Hash(), "number", block.Number())
+		start := time.Now()
 
 		defer func() {
 			e.proposedBlockHash = common.Hash{}
 			e.sealMu.Unlock()
 		}()
 
+		// wait until the timestamp of the header, which enforces the block period
+		delay := time.Until(time.Unix(int64(header.Time), 0))
+		select {
+		case <-time.After(delay):
+		case <-stop:
+			results <- nil
+			return
+		}
+
 		// post block into Hotstuff engine
 		go e.EventMux().Post(event2.RequestEvent{
 			Proposal: block,
@@ -186,9 +390,10 @@ func (e *HotStuffEngine) Seal(chain consensus.ChainHeaderReader, block *types.Bl
 		for {
 			select {
 			case result := <-e.commitCh:
-				// if the block hash and the hash from channel are the same,
-				// return the result. Otherwise, keep waiting the next hash.
-				if result != nil && block.Hash() == result.Hash() {
+				// if the block hash and the proposal hash of the committed block are
+				// the same, return the result. Otherwise, keep waiting the next hash.
+				if result != nil && e.proposedBlockHash == proposalHash(result.Header()) {
+					sealTimer.UpdateSince(start)
 					results <- result
 					return
 				}
@@ -240,10 +445,14 @@ func (e *HotStuffEngine) Start(chain consensus.ChainReader, currentBlock func()
 	e.chain = chain
 	e.currentBlock = currentBlock
 	e.getBlockByHash = getBlockByHash
+	if e.config.Chained {
+		e.loadCertified()
+	}
 
 	if err := e.core.Start(chain); err != nil {
 		return err
 	}
+	e.subscribeChainHead(chain)
 
 	e.coreStarted = true
 	return nil
@@ -251,29 +460,77 @@ func (e *HotStuffEngine) Start(chain consensus.ChainReader, currentBlock func()
 
 // Stop stops the engine
 func (e *HotStuffEngine) Stop() error {
+	e.coreMu.Lock()
+	defer e.coreMu.Unlock()
+
+	if !e.coreStarted {
+		return ErrStoppedEngine
+	}
+	if e.headSub != nil {
+		e.headSub.Unsubscribe()
+		e.headSub = nil
+	}
+	if err := e.core.Stop(); err != nil {
+		return err
+	}
+	e.coreStarted = false
 	return nil
 }
 
+// subscribeChainHead reports every new chain head to the core as a final committed
+// header, so that the core moves on to the next height whichever way the block
+// reached the chain.
+func (e *HotStuffEngine) subscribeChainHead(chain consensus.ChainReader) {
+	reader, ok := chain.(chainHeadReader)
+	if !ok {
+		return
+	}
+	ch := make(chan core2.ChainHeadEvent, 10)
+	e.headSub = reader.SubscribeChainHeadEvent(ch)
+
+	go func(sub event.Subscription) {
+		for {
+			select {
+			case ev := <-ch:
+				e.EventMux().Post(event2.FinalCommittedEvent{Header: ev.Block.Header()})
+			case <-sub.Err():
+				return
+			}
+		}
+	}(e.headSub)
+}
+
 // verifyHeader checks whether a header conforms to the consensus rules.The
 // caller may optionally pass in a batch of parents (ascending order) to avoid
 // looking those up from the database. This is useful for concurrently verifying
 // a batch of new headers.
 func (e *HotStuffEngine) verifyHeader(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header, seal bool) error {
+	aggregated, err := e.verifyUnsealedHeader(chain, header, parents, seal)
+	if err != nil || aggregated == nil {
+		return err
+	}
+	return aggregated.Verify()
+}
+
+// verifyUnsealedHeader checks whether a header conforms to the consensus rules,
+// except for the aggregated seal of the validators. If requested, the seal is
+// returned for the caller to verify it, alone or in batch.
+func (e *HotStuffEngine) verifyUnsealedHeader(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header, seal bool) (*core.AggregatedSeal, error) {
 	if header.Number == nil {
-		return errUnknownBlock
+		return nil, errUnknownBlock
 	}
 
 	// Ensure that the mix digest is zero as we don't have fork protection currently
 	if header.MixDigest != types.HotstuffDigest {
-		return errInvalidMixDigest
+		return nil, errInvalidMixDigest
 	}
 	// Ensure that the block doesn't contain any uncles which are meaningless in Istanbul
 	if header.UncleHash != nilUncleHash {
-		return errInvalidUncleHash
+		return nil, errInvalidUncleHash
 	}
 	// Ensure that the block's difficulty is meaningful (may not be correct at this point)
 	if header.Difficulty == nil || header.Difficulty.Cmp(defaultDifficulty) != 0 {
-		return errInvalidDifficulty
+		return nil, errInvalidDifficulty
 	}
 
 	// verifyCascadingFields verifies all the header fields that are not standalone,
@@ -283,7 +540,7 @@ func (e *HotStuffEngine) verifyHeader(chain consensus.ChainHeaderReader, header
 	// The genesis block is the always valid dead-end
 	number := header.Number.Uint64()
 	if number == 0 {
-		return nil
+		return nil, nil
 	}
 
 	// Ensure that the block's timestamp isn't too close to it's parent
@@ -294,18 +551,262 @@ func (e *HotStuffEngine) verifyHeader(chain consensus.ChainHeaderReader, header
 		parent = chain.GetHeader(header.ParentHash, number-1)
 	}
 	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
-		return consensus.ErrUnknownAncestor
+		return nil, consensus.ErrUnknownAncestor
 	}
 	if header.Time > parent.Time+e.config.BlockPeriod && header.Time > uint64(now().Unix()) {
-		return errInvalidTimestamp
+		return nil, errInvalidTimestamp
 	}
-	// Hotstuff ToDo: validator management
-	vals, err := e.signer.GetValidators(e.amountValidator)
+	// Retrieve the snapshot needed to verify this header and cache it
+	snap, err := e.snapshot(chain, number-1, header.ParentHash, parents)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	// Ensure that only the checkpoint blocks record the validator set, which
+	// must be the one sealing the checkpoint
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, errInvalidExtraDataFormat
+	}
+	if number%snap.Epoch == 0 {
+		if snap.Staking {
+			if err := e.verifyStakingCheckpoint(chain, parent, snap, extra); err != nil {
+				return nil, err
+			}
+		} else {
+			if !snap.checkpointValidators(extra.Validators) {
+				return nil, errInvalidCheckpointValidators
+			}
+			if !equalPublicKeys(extra.PublicKeys, snap.checkpointPublicKeys(extra.Validators)) {
+				return nil, errInvalidCheckpointPublicKeys
+			}
+		}
+		if extra.Vote != nil {
+			return nil, errInvalidCheckpointVote
+		}
+		if len(extra.Evidence) != 0 {
+			return nil, errInvalidCheckpointEvidence
+		}
+	} else if len(extra.Validators) != 0 || len(extra.PublicKeys) != 0 {
+		return nil, errInvalidNonCheckpointValidators
+	} else if snap.Staking && extra.Vote != nil {
+		return nil, errInvalidStakingVote
+	}
+	if err := verifyVote(extra.Vote); err != nil {
+		return nil, err
+	}
+	if err := e.verifyEvidence(number, extra.Evidence, snap); err != nil {
+		return nil, err
+	}
+	if !snap.validator(header.Coinbase) {
+		return nil, errUnauthorized
+	}
+	valSet := snap.ValSet(e.policy(chain))
+	if valSet.Policy() == interfaces.VRF {
+		if err := e.verifyElection(header, parent, valSet.Copy()); err != nil {
+			return nil, err
+		}
+	}
+	if err := e.signer.EthSigner.VerifyLeaderSeal(header); err != nil {
+		return nil, err
+	}
+	if !seal {
+		return nil, nil
+	}
+	return e.signer.BlsSigner.ValidatorSeal(header, valSet, e.config.Chained)
+}
+
+// snapshot retrieves the validator snapshot at a given point in time.
+func (e *HotStuffEngine) snapshot(chain consensus.ChainHeaderReader, number uint64, hash common.Hash, parents []*types.Header) (*Snapshot, error) {
+	// Search for a snapshot in memory or on disk for checkpoints
+	var (
+		headers []*types.Header
+		snap    *Snapshot
+		epoch   = e.Epoch(chain)
+	)
+	for snap == nil {
+		// If an in-memory snapshot was found, use that
+		if s, ok := e.recents.Get(hash); ok {
+			snap = s.(*Snapshot)
+			break
+		}
+		// If an on-disk checkpoint snapshot can be found, use that
+		if number%checkpointInterval == 0 {
+			if s, err := loadSnapshot(e.db, hash); err == nil {
+				e.logger.Trace("Loaded validator snapshot from disk", "number", number, "hash", hash)
+				snap = s
+				break
+			}
+		}
+		// If we're at the genesis, snapshot the initial state. Alternatively if we're
+		// at a checkpoint block without a parent (light client CHT), or we have piled
+		// up more headers than allowed to be reorged (chain reinit from a freezer),
+		// consider the checkpoint trusted and snapshot it.
+		if number == 0 || (number%epoch == 0 && (len(headers) > params.FullImmutabilityThreshold || chain.GetHeaderByNumber(number-1) == nil)) {
+			checkpoint := chain.GetHeaderByNumber(number)
+			if checkpoint != nil {
+				hash := checkpoint.Hash()
+
+				extra, err := types.ExtractHotstuffExtra(checkpoint)
+				if err != nil {
+					return nil, errInvalidExtraDataFormat
+				}
+				snap = newSnapshot(epoch, number, hash, extra.Validators)
+				snap.Staking = chain.Config().HotStuff != nil && chain.Config().HotStuff.Staking != nil
+				for i, key := range extra.PublicKeys {
+					if i < len(extra.Validators) && len(key) > 0 {
+						snap.PublicKeys[extra.Validators[i]] = common.CopyBytes(key)
+					}
+				}
+				if number == 0 {
+					if err := e.genesisPublicKeys(chain, snap); err != nil {
+						return nil, err
+					}
+				}
+				if err := snap.store(e.db); err != nil {
+					return nil, err
+				}
+				e.logger.Info("Stored checkpoint validator snapshot to disk", "number", number, "hash", hash)
+				break
+			}
+		}
+		// No snapshot for this header, gather the header and move backward
+		var header *types.Header
+		if len(parents) > 0 {
+			// If we have explicit parents, pick from there (enforced)
+			header = parents[len(parents)-1]
+			if header.Hash() != hash || header.Number.Uint64() != number {
+				return nil, consensus.ErrUnknownAncestor
+			}
+			parents = parents[:len(parents)-1]
+		} else {
+			// No explicit parents (or no more left), reach out to the database
+			header = chain.GetHeader(hash, number)
+			if header == nil {
+				return nil, consensus.ErrUnknownAncestor
+			}
+		}
+		headers = append(headers, header)
+		number, hash = number-1, header.ParentHash
+	}
+	// Previous snapshot found, apply any pending headers on top of it
+	for i := 0; i < len(headers)/2; i++ {
+		headers[i], headers[len(headers)-1-i] = headers[len(headers)-1-i], headers[i]
+	}
+	snap, err := snap.apply(headers)
+	if err != nil {
+		return nil, err
+	}
+	e.recents.Add(snap.Hash, snap)
+
+	// If we've generated a new checkpoint snapshot, save to disk
+	if snap.Number%checkpointInterval == 0 && len(headers) > 0 {
+		if err = snap.store(e.db); err != nil {
+			return nil, err
+		}
+		e.logger.Trace("Stored validator snapshot to disk", "number", snap.Number, "hash", snap.Hash)
+	}
+	return snap, err
+}
+
+// genesisPublicKeys records the consensus keys the chain config assigns to the
+// genesis validators into the snapshot.
+func (e *HotStuffEngine) genesisPublicKeys(chain consensus.ChainHeaderReader, snap *Snapshot) error {
+	conf := chain.Config().HotStuff
+	if conf == nil {
+		return nil
+	}
+	for _, val := range conf.Validators {
+		if !snap.validator(val.Address) {
+			continue
+		}
+		if _, err := blst.PublicKeyFromBytes(val.PublicKey); err != nil {
+			return fmt.Errorf("invalid consensus key of genesis validator %s: %v", val.Address, err)
+		}
+		snap.PublicKeys[val.Address] = common.CopyBytes(val.PublicKey)
+	}
+	return nil
+}
+
+// Epoch returns the number of blocks between two validator checkpoints, as set
+// in the chain config, falling back to the engine config.
+func (e *HotStuffEngine) Epoch(chain consensus.ChainHeaderReader) uint64 {
+	if conf := chain.Config().HotStuff; conf != nil && conf.Epoch != 0 {
+		return conf.Epoch
+	}
+	if e.config.Epoch != 0 {
+		return e.config.Epoch
+	}
+	return defaultEpoch
+}
+
+// getValidators returns the validator set sealing the child of the given block.
+func (e *HotStuffEngine) getValidators(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) interfaces.ValidatorSet {
+	valSet, err := e.validators(chain, number, hash)
+	if err != nil {
+		e.logger.Warn("Failed to retrieve validator snapshot", "number", number, "hash", hash, "err", err)
+		return validator.NewSet(nil, e.policy(chain))
+	}
+	return valSet
+}
+
+// validators is getValidators, failing if the snapshot at the block is unknown.
+func (e *HotStuffEngine) validators(chain consensus.ChainHeaderReader, number uint64, hash common.Hash) (interfaces.ValidatorSet, error) {
+	snap, err := e.snapshot(chain, number, hash, nil)
+	if err != nil {
+		return nil, err
+	}
+	valSet := snap.ValSet(e.policy(chain))
+	if valSet.Policy() == interfaces.VRF {
+		if header := chain.GetHeader(hash, number); header != nil {
+			valSet.SetSeed(vrfSeed(header))
+		}
+	}
+	return valSet, nil
+}
+
+// CurrentView implements consensus.Hotstuff, returning the view of the core.
+func (e *HotStuffEngine) CurrentView() (*big.Int, *big.Int, string, bool) {
+	return e.core.CurrentView()
+}
+
+// ValidatorsAt implements consensus.Hotstuff, retrieving the validators of the
+// snapshot at the given header.
+func (e *HotStuffEngine) ValidatorsAt(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	snap, err := e.snapshot(chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return snap.Validators, nil
+}
+
+// Participants implements consensus.Hotstuff, retrieving the validators whose
+// votes are aggregated in the quorum certificate of the given header.
+func (e *HotStuffEngine) Participants(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	if header.Number.Sign() == 0 {
+		return nil, errUnknownBlock
+	}
+	return e.signers(chain, header)
+}
+
+// signers returns the validators whose seals are aggregated in the given header.
+func (e *HotStuffEngine) signers(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, errInvalidExtraDataFormat
+	}
+	valSet, err := e.validators(chain, header.Number.Uint64()-1, header.ParentHash)
+	if err != nil {
+		return nil, err
+	}
+	if err := extra.Participants.Validate(valSet.Size()); err != nil {
+		return nil, err
+	}
+	indices := extra.Participants.Indices()
+	signers := make([]common.Address, 0, len(indices))
+	for _, index := range indices {
+		signers = append(signers, valSet.GetByIndex(uint64(index)).Address())
 	}
-	// Hotstuff ToDo: validator store
-	return e.signer.VerifyHeader(header, validator.NewSet(vals, interfaces.RoundRobin), seal)
+	return signers, nil
 }
 
 func (e *HotStuffEngine) getPendingParentHeader(chain consensus.ChainHeaderReader, header *types.Header) (*types.Header, error) {
@@ -0,0 +1,168 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSealPeriod(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sk, _ := blst.RandKey()
+	conf := *config.DefaultBasicConfig
+	conf.BlockPeriod = 0
+	e := New(key, &sk, &conf, rawdb.NewMemoryDatabase()).(*HotStuffEngine)
+
+	header := &types.Header{Number: big.NewInt(1), Time: uint64(time.Now().Add(time.Minute).Unix())}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, []common.Address{e.Address()}))
+
+	// Instant chains refuse to seal empty blocks
+	results := make(chan *types.Block, 1)
+	stop := make(chan struct{})
+	assert.Equal(t, errWaitTransactions, e.Seal(nil, types.NewBlockWithHeader(header), results, stop))
+
+	// Sealing waits for the timestamp of the block, and can be aborted meanwhile
+	e.config.BlockPeriod = config.DefaultBasicConfig.BlockPeriod
+	assert.NoError(t, e.Seal(nil, types.NewBlockWithHeader(header), results, stop))
+	select {
+	case <-results:
+		t.Fatal("block sealed before its timestamp")
+	case <-time.After(100 * time.Millisecond):
+	}
+	close(stop)
+	select {
+	case block := <-results:
+		assert.Nil(t, block)
+	case <-time.After(time.Second):
+		t.Fatal("aborted sealing did not return")
+	}
+}
+
+// Tests that the seals of a long run of headers are batch verified, pinning
+// down the invalid ones.
+func TestVerifyHeaders(t *testing.T) {
+	var (
+		keys    = make(map[common.Address]*ecdsa.PrivateKey)
+		blsKeys = make(map[common.Address]blscommon.SecretKey)
+		addrs   []common.Address
+		pubKeys [][]byte
+		db      = rawdb.NewMemoryDatabase()
+	)
+	for i := 0; i < 4; i++ {
+		key, _ := crypto.GenerateKey()
+		sk, _ := blst.RandKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		keys[addr], blsKeys[addr] = key, sk
+		addrs = append(addrs, addr)
+	}
+	sortAddresses(addrs)
+	for _, addr := range addrs {
+		pubKeys = append(pubKeys, blsKeys[addr].PublicKey().Marshal())
+	}
+	genesis := &types.Header{
+		Number:     common.Big0,
+		Difficulty: defaultDifficulty,
+		MixDigest:  types.HotstuffDigest,
+		UncleHash:  nilUncleHash,
+	}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(genesis, addrs))
+	assert.NoError(t, fillPublicKeys(genesis, pubKeys))
+	chain := &testCheckpointChain{
+		config:     &params.ChainConfig{HotStuff: &params.HotStuffConfig{Epoch: 1000}},
+		checkpoint: genesis,
+	}
+	// Seal a run of headers spanning several batches, one with a forged seal
+	const forged = 2*sealBatchSize + 5
+	var (
+		headers []*types.Header
+		parent  = genesis
+	)
+	for i := 1; i <= 3*sealBatchSize; i++ {
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     big.NewInt(int64(i)),
+			Time:       parent.Time + 1,
+			Coinbase:   addrs[i%len(addrs)],
+			Difficulty: defaultDifficulty,
+			MixDigest:  types.HotstuffDigest,
+			UncleHash:  nilUncleHash,
+		}
+		assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, nil))
+		assert.NoError(t, core.NewEthSigner(keys[header.Coinbase], db).SealBeforeCommit(header))
+
+		digest := core.SealDigest(header, 0, false)
+		if len(headers) == forged {
+			digest = common.Hash{}
+		}
+		extra, _ := types.ExtractHotstuffExtra(header)
+		extra.Participants = types.NewHotstuffBitmap(len(addrs))
+		var sigs []blscommon.Signature
+		for index, addr := range addrs[:3] {
+			extra.Participants.Set(index)
+			sigs = append(sigs, blsKeys[addr].Sign(digest.Bytes()))
+		}
+		extra.AggregatedValidatorsSeal = blst.AggregateSignatures(sigs).Marshal()
+		payload, _ := rlp.EncodeToBytes(&extra)
+		header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+
+		headers = append(headers, header)
+		parent = header
+	}
+	key, _ := crypto.GenerateKey()
+	sk, _ := blst.RandKey()
+	e := New(key, &sk, config.DefaultBasicConfig, db).(*HotStuffEngine)
+
+	seals := make([]bool, len(headers))
+	for i := range seals {
+		seals[i] = true
+	}
+	_, results := e.VerifyHeaders(chain, headers, seals)
+	for i := range headers {
+		select {
+		case err := <-results:
+			if i == forged {
+				assert.Error(t, err, "header %d", i)
+			} else {
+				assert.NoError(t, err, "header %d", i)
+			}
+		case <-time.After(10 * time.Second):
+			t.Fatalf("header %d: verification timed out", i)
+		}
+	}
+	// The seals are skipped if not requested
+	_, results = e.VerifyHeaders(chain, headers, nil)
+	for i := range headers {
+		assert.NoError(t, <-results, "header %d", i)
+	}
+}
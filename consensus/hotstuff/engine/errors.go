@@ -33,6 +33,46 @@ var (
 	errMismatchTxhashes = errors.New("mismatch transactions hashes")
 	// errDecodeFailed is returned if the message can't be decode
 	errDecodeFailed = errors.New("decode p2p message failed")
+	// errUnknownSnapshot is returned if no snapshot is stored for the requested block.
+	errUnknownSnapshot = errors.New("unknown snapshot")
+	// errInvalidVotingChain is returned if an authorization list is attempted to
+	// be modified via out-of-range or non-contiguous headers.
+	errInvalidVotingChain = errors.New("invalid voting chain")
+	// errInvalidCheckpointValidators is returned if a checkpoint block contains an
+	// invalid list of validators.
+	errInvalidCheckpointValidators = errors.New("invalid validator list on checkpoint block")
+	// errInvalidNonCheckpointValidators is returned if a non-checkpoint block
+	// contains a list of validators.
+	errInvalidNonCheckpointValidators = errors.New("non-checkpoint block contains validators")
+	// errInvalidCheckpointPublicKeys is returned if a checkpoint block does not record
+	// the consensus keys of its validators.
+	errInvalidCheckpointPublicKeys = errors.New("invalid consensus keys on checkpoint block")
+	// errInvalidCheckpointNumber is returned if a checkpoint verified by a light
+	// client does not follow its trusted checkpoint by one epoch.
+	errInvalidCheckpointNumber = errors.New("invalid checkpoint number")
+	// errInvalidCheckpointVote is returned if a checkpoint block contains a vote.
+	errInvalidCheckpointVote = errors.New("vote in checkpoint block")
+	// errInvalidVote is returned if a vote carries a missing or malformed consensus
+	// key, or no proof of its possession by the candidate.
+	errInvalidVote = errors.New("invalid vote consensus key")
+	// errInvalidStakingVote is returned if a block of a chain electing its
+	// validators by stake contains a vote.
+	errInvalidStakingVote = errors.New("vote on staking chain")
+	// errInvalidCheckpointEvidence is returned if a checkpoint block contains an evidence.
+	errInvalidCheckpointEvidence = errors.New("evidence in checkpoint block")
+	// errInvalidEvidence is returned if an evidence does not prove an equivocation
+	// of a validator which can still be slashed.
+	errInvalidEvidence = errors.New("invalid equivocation evidence")
+	// errInvalidProposer is returned if a block is proposed by a validator which
+	// was not elected in the round it claims.
+	errInvalidProposer = errors.New("proposer not elected in round")
+	// errInvalidVRFProof is returned if the salt of a block does not carry a valid
+	// VRF proof of the proposer's election.
+	errInvalidVRFProof = errors.New("invalid VRF proof")
+	// errWaitTransactions is returned if an empty block is attempted to be sealed
+	// on an instant chain (0 second period). It's important to refuse these as the
+	// block reward is zero, so an empty block just bloats the chain.
+	errWaitTransactions = errors.New("waiting for transactions")
 	// errBadProposal
 	errBADProposal = errors.New("bad proposal")
 )
@@ -0,0 +1,122 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// maxPendingEvidence is the number of evidences waiting to be included in a
+// block above which new ones are dropped.
+const maxPendingEvidence = 64
+
+// AddEvidence implements interfaces.Backend.AddEvidence, it keeps the evidence
+// until a block including it is proposed by the local validator.
+func (e *HotStuffEngine) AddEvidence(evidence *types.HotstuffEvidence) {
+	e.evidenceMu.Lock()
+	defer e.evidenceMu.Unlock()
+
+	if len(e.evidence) >= maxPendingEvidence {
+		e.logger.Debug("Dropped evidence, too many pending", "offender", evidence.Offender)
+		return
+	}
+	e.evidence[evidence.Hash()] = evidence
+}
+
+// evidenceEpoch returns the range of heights the evidences included in the
+// block of the given number may prove an equivocation at: the heights of its
+// epoch up to the block itself. Older evidences could be replayed to slash a
+// validator again once it is voted back in.
+func evidenceEpoch(number uint64, epoch uint64) (uint64, uint64) {
+	return number - number%epoch + 1, number
+}
+
+// pickEvidence selects the evidences against validators which can be slashed in
+// the block of the given number, on top of the given snapshot, one per offender.
+// The others are dropped as they are stale, or their offender is already slashed
+// or gone.
+func (e *HotStuffEngine) pickEvidence(number uint64, snap *Snapshot) []*types.HotstuffEvidence {
+	e.evidenceMu.Lock()
+	defer e.evidenceMu.Unlock()
+
+	var (
+		evidence    []*types.HotstuffEvidence
+		next        = snap.copy()
+		valSet      = snap.ValSet(interfaces.RoundRobin)
+		first, last = evidenceEpoch(number, snap.Epoch)
+		picked      = make(map[common.Address]bool)
+	)
+	for hash, ev := range e.evidence {
+		if picked[ev.Offender] {
+			continue
+		}
+		if err := e.signer.VerifyEvidence(ev, valSet, first, last); err != nil {
+			delete(e.evidence, hash)
+			continue
+		}
+		if !next.slash(ev.Offender) {
+			delete(e.evidence, hash)
+			continue
+		}
+		picked[ev.Offender] = true
+		evidence = append(evidence, ev)
+	}
+	return evidence
+}
+
+// verifyEvidence checks the evidences included in the block of the given number,
+// each of which must slash a different validator of the snapshot for an
+// equivocation within the epoch of the block.
+func (e *HotStuffEngine) verifyEvidence(number uint64, evidence []*types.HotstuffEvidence, snap *Snapshot) error {
+	if len(evidence) == 0 {
+		return nil
+	}
+	var (
+		next        = snap.copy()
+		valSet      = snap.ValSet(interfaces.RoundRobin)
+		first, last = evidenceEpoch(number, snap.Epoch)
+	)
+	for _, ev := range evidence {
+		if !next.slash(ev.Offender) {
+			return errInvalidEvidence
+		}
+		if err := e.signer.VerifyEvidence(ev, valSet, first, last); err != nil {
+			return errInvalidEvidence
+		}
+	}
+	return nil
+}
+
+// fillEvidence records the evidences in the extra-data of the header.
+func fillEvidence(header *types.Header, evidence []*types.HotstuffEvidence) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	extra.Evidence = evidence
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	return nil
+}
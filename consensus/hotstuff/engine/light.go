@@ -0,0 +1,77 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// VerifyCheckpoint checks that the header is the checkpoint following the trusted
+// one, sealed by a quorum of the validators the trusted checkpoint records. The
+// validator set only changes at checkpoints, so light clients can follow it from
+// one epoch to the next without the headers in between.
+func (e *HotStuffEngine) VerifyCheckpoint(chain consensus.ChainHeaderReader, trusted, header *types.Header) error {
+	if header.Number == nil {
+		return errUnknownBlock
+	}
+	epoch := e.Epoch(chain)
+	number := trusted.Number.Uint64()
+	if number%epoch != 0 || header.Number.Uint64() != number+epoch {
+		return errInvalidCheckpointNumber
+	}
+	if header.MixDigest != types.HotstuffDigest {
+		return errInvalidMixDigest
+	}
+	if header.UncleHash != nilUncleHash {
+		return errInvalidUncleHash
+	}
+	if header.Difficulty == nil || header.Difficulty.Cmp(defaultDifficulty) != 0 {
+		return errInvalidDifficulty
+	}
+	if header.Time <= trusted.Time {
+		return errInvalidTimestamp
+	}
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return errInvalidExtraDataFormat
+	}
+	if len(extra.Validators) == 0 {
+		return errInvalidCheckpointValidators
+	}
+	if len(extra.PublicKeys) != len(extra.Validators) {
+		return errInvalidCheckpointPublicKeys
+	}
+	if extra.Vote != nil {
+		return errInvalidCheckpointVote
+	}
+	if len(extra.Evidence) != 0 {
+		return errInvalidCheckpointEvidence
+	}
+	// The trusted checkpoint is the last block the local chain holds, its snapshot
+	// is rebuilt from the validators and the consensus keys it records
+	snap, err := e.snapshot(chain, number, trusted.Hash(), nil)
+	if err != nil {
+		return err
+	}
+	if !snap.validator(header.Coinbase) {
+		return errUnauthorized
+	}
+	return e.signer.VerifyHeader(header, snap.ValSet(e.policy(chain)), e.config.Chained, true)
+}
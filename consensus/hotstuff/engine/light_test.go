@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCheckpointChain is a light chain holding a single trusted checkpoint.
+type testCheckpointChain struct {
+	config     *params.ChainConfig
+	checkpoint *types.Header
+}
+
+func (c *testCheckpointChain) Config() *params.ChainConfig  { return c.config }
+func (c *testCheckpointChain) CurrentHeader() *types.Header { return c.checkpoint }
+
+func (c *testCheckpointChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if hash == c.checkpoint.Hash() && number == c.checkpoint.Number.Uint64() {
+		return c.checkpoint
+	}
+	return nil
+}
+
+func (c *testCheckpointChain) GetHeaderByNumber(number uint64) *types.Header {
+	if number == c.checkpoint.Number.Uint64() {
+		return c.checkpoint
+	}
+	return nil
+}
+
+func (c *testCheckpointChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	return c.GetHeader(hash, c.checkpoint.Number.Uint64())
+}
+
+func TestVerifyCheckpoint(t *testing.T) {
+	const epoch = 4
+	var (
+		keys     = make(map[common.Address]*ecdsa.PrivateKey)
+		blsKeys  = make(map[common.Address]blscommon.SecretKey)
+		addrs    []common.Address
+		pubKeys  [][]byte
+		db       = rawdb.NewMemoryDatabase()
+		chainCfg = &params.ChainConfig{HotStuff: &params.HotStuffConfig{Epoch: epoch}}
+	)
+	for i := 0; i < 4; i++ {
+		key, _ := crypto.GenerateKey()
+		sk, _ := blst.RandKey()
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		keys[addr], blsKeys[addr] = key, sk
+		addrs = append(addrs, addr)
+	}
+	sortAddresses(addrs)
+	for _, addr := range addrs {
+		pubKeys = append(pubKeys, blsKeys[addr].PublicKey().Marshal())
+	}
+	checkpoint := func(number uint64, time uint64, vals []common.Address, keys [][]byte) *types.Header {
+		header := &types.Header{
+			Number:     new(big.Int).SetUint64(number),
+			Time:       time,
+			Coinbase:   addrs[0],
+			Difficulty: defaultDifficulty,
+			MixDigest:  types.HotstuffDigest,
+			UncleHash:  nilUncleHash,
+		}
+		assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, vals))
+		assert.NoError(t, fillPublicKeys(header, keys))
+		return header
+	}
+	// seal signs the header by the proposer and the given validators of the trusted epoch
+	seal := func(header *types.Header, signers []common.Address) *types.Header {
+		assert.NoError(t, core.NewEthSigner(keys[header.Coinbase], db).SealBeforeCommit(header))
+
+		digest := core.SealDigest(header, 0, false)
+		valSet := validator.NewSet(addrs, interfaces.RoundRobin)
+		extra, _ := types.ExtractHotstuffExtra(header)
+		extra.Participants = types.NewHotstuffBitmap(valSet.Size())
+		var sigs []blscommon.Signature
+		for _, addr := range signers {
+			index, _ := valSet.GetByAddress(addr)
+			extra.Participants.Set(index)
+			sigs = append(sigs, blsKeys[addr].Sign(digest.Bytes()))
+		}
+		extra.AggregatedValidatorsSeal = blst.AggregateSignatures(sigs).Marshal()
+		payload, _ := rlp.EncodeToBytes(&extra)
+		header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+		return header
+	}
+	trusted := checkpoint(epoch, 100, addrs, pubKeys)
+	chain := &testCheckpointChain{config: chainCfg, checkpoint: trusted}
+
+	key, _ := crypto.GenerateKey()
+	sk, _ := blst.RandKey()
+	e := New(key, &sk, config.DefaultBasicConfig, db).(*HotStuffEngine)
+
+	// The next checkpoint sealed by a quorum of the trusted validators is accepted,
+	// whatever validator set it records for the following epoch
+	next := seal(checkpoint(2*epoch, 200, addrs[:3], pubKeys[:3]), addrs[:3])
+	assert.NoError(t, e.VerifyCheckpoint(chain, trusted, next))
+
+	// Seals short of a quorum are rejected
+	assert.Error(t, e.VerifyCheckpoint(chain, trusted, seal(checkpoint(2*epoch, 200, addrs, pubKeys), addrs[:2])))
+
+	// Only the checkpoint right after the trusted one can be verified
+	assert.Equal(t, errInvalidCheckpointNumber, e.VerifyCheckpoint(chain, trusted, seal(checkpoint(3*epoch, 300, addrs, pubKeys), addrs)))
+
+	// Checkpoints must record the consensus keys of their validators
+	assert.Equal(t, errInvalidCheckpointPublicKeys, e.VerifyCheckpoint(chain, trusted, seal(checkpoint(2*epoch, 200, addrs, pubKeys[:3]), addrs)))
+}
@@ -0,0 +1,28 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import "github.com/ethereum/go-ethereum/metrics"
+
+var (
+	sealTimer      = metrics.NewRegisteredTimer("consensus/hotstuff/seal", nil)      // Time from sealing a local block to its commit
+	committedMeter = metrics.NewRegisteredMeter("consensus/hotstuff/committed", nil) // Blocks committed by consensus, local or not
+	importedMeter  = metrics.NewRegisteredMeter("consensus/hotstuff/imported", nil)  // Committed blocks imported rather than sealed locally
+	reusedMeter    = metrics.NewRegisteredMeter("consensus/hotstuff/reused", nil)    // Imported blocks written with the state of their validation
+)
@@ -0,0 +1,84 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+var big100 = big.NewInt(100)
+
+// accumulateRewards credits the block reward configured for the chain to the
+// proposer of the header, the treasury and the validators whose votes are
+// aggregated in the QC of the parent block. The participants depend on the
+// chain only, so failing to retrieve them is an error rather than a reason to
+// pay the proposer, which would fork the state of the nodes missing them.
+func (e *HotStuffEngine) accumulateRewards(chain consensus.ChainHeaderReader, state *state.StateDB, header *types.Header) error {
+	conf := chain.Config().HotStuff
+	if conf == nil || conf.BlockReward == nil || conf.BlockReward.Sign() <= 0 {
+		return nil
+	}
+	var participants []common.Address
+	if number := header.Number.Uint64(); number > 1 {
+		parent := chain.GetHeader(header.ParentHash, number-1)
+		if parent == nil {
+			return consensus.ErrUnknownAncestor
+		}
+		signers, err := e.signers(chain, parent)
+		if err != nil {
+			return err
+		}
+		participants = signers
+	}
+	distributeRewards(state, conf, header.Coinbase, participants)
+	return nil
+}
+
+// distributeRewards splits the block reward between the proposer, the treasury
+// and the participants, who are paid an equal share each. The participants'
+// share goes to the proposer if there are none, as does the remainder of its
+// division.
+func distributeRewards(state *state.StateDB, conf *params.HotStuffConfig, proposer common.Address, participants []common.Address) {
+	reward := conf.BlockReward
+
+	proposerReward := new(big.Int).Mul(reward, new(big.Int).SetUint64(conf.ProposerShare))
+	proposerReward.Div(proposerReward, big100)
+
+	remaining := new(big.Int).Sub(reward, proposerReward)
+	if conf.Treasury != nil {
+		treasuryReward := new(big.Int).Mul(reward, new(big.Int).SetUint64(conf.TreasuryShare))
+		treasuryReward.Div(treasuryReward, big100)
+		state.AddBalance(*conf.Treasury, treasuryReward)
+		remaining.Sub(remaining, treasuryReward)
+	}
+	if len(participants) > 0 {
+		share := new(big.Int).Div(remaining, big.NewInt(int64(len(participants))))
+		for _, participant := range participants {
+			state.AddBalance(participant, share)
+		}
+		remaining.Sub(remaining, new(big.Int).Mul(share, big.NewInt(int64(len(participants)))))
+	}
+	state.AddBalance(proposer, proposerReward.Add(proposerReward, remaining))
+}
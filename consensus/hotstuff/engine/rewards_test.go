@@ -0,0 +1,137 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	core2 "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDistributeRewards(t *testing.T) {
+	var (
+		proposer = common.HexToAddress("0x0a")
+		b        = common.HexToAddress("0x0b")
+		c        = common.HexToAddress("0x0c")
+		d        = common.HexToAddress("0x0d")
+		treasury = common.HexToAddress("0xfe")
+	)
+	newState := func() *state.StateDB {
+		statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+		return statedb
+	}
+	conf := &params.HotStuffConfig{
+		BlockReward:   big.NewInt(1000),
+		ProposerShare: 30,
+		Treasury:      &treasury,
+		TreasuryShare: 10,
+	}
+
+	// The participants share the rest, the proposer gets the remainder
+	statedb := newState()
+	distributeRewards(statedb, conf, proposer, []common.Address{proposer, b, c, d, common.HexToAddress("0x0e"), common.HexToAddress("0x0f"), common.HexToAddress("0x10")})
+	assert.Equal(t, big.NewInt(100), statedb.GetBalance(treasury))
+	assert.Equal(t, big.NewInt(85), statedb.GetBalance(b))
+	assert.Equal(t, big.NewInt(300+85+5), statedb.GetBalance(proposer))
+
+	// Without participants or treasury, the proposer gets everything left
+	conf.Treasury = nil
+	statedb = newState()
+	distributeRewards(statedb, conf, proposer, nil)
+	assert.Equal(t, big.NewInt(1000), statedb.GetBalance(proposer))
+	assert.Equal(t, new(big.Int), statedb.GetBalance(treasury))
+}
+
+// Tests that blocks reward the participants of the QC sealed into their parent,
+// and fail rather than pay the proposer if the parent is unknown.
+func TestAccumulateRewards(t *testing.T) {
+	var (
+		key, _   = crypto.GenerateKey()
+		sk, _    = blst.RandKey()
+		addr     = crypto.PubkeyToAddress(key.PublicKey)
+		proposer = common.HexToAddress("0x0a")
+		db       = rawdb.NewMemoryDatabase()
+		genesis  = core2.DeveloperHotstuffGenesisBlock(1, addr, sk.PublicKey().Marshal(), addr)
+	)
+	genesis.Config.HotStuff.BlockReward = big.NewInt(1000)
+	genesis.Config.HotStuff.ProposerShare = 30
+	genesis.MustCommit(db)
+
+	e := New(key, &sk, config.FromChainConfig(genesis.Config.HotStuff), db).(*HotStuffEngine)
+	chain, err := core2.NewBlockChain(db, nil, genesis.Config, e, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+	e.chain = chain
+
+	// Commit a block certified by the single validator
+	parent := chain.CurrentBlock()
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     big.NewInt(1),
+		GasLimit:   parent.GasLimit(),
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+	}
+	assert.NoError(t, e.Prepare(chain, header))
+	statedb, _ := chain.StateAt(parent.Root())
+	block, err := e.FinalizeAndAssemble(chain, header, statedb, nil, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to assemble block: %v", err)
+	}
+	header = block.Header()
+	assert.NoError(t, e.signer.EthSigner.SealBeforeCommit(header))
+	proposal := block.WithSeal(header)
+	assert.NoError(t, e.ValidateBlock(proposal))
+
+	digest := core.SealDigest(proposal.Header(), 0, false)
+	seal := blst.AggregateSignatures([]blscommon.Signature{sk.Sign(digest.Bytes())}).Marshal()
+	sealed, err := e.PreCommit(proposal, 0, []common.Address{addr}, seal)
+	if err != nil {
+		t.Fatalf("failed to seal proposal: %v", err)
+	}
+	assert.NoError(t, e.Commit(sealed.(*types.Block)))
+
+	// Its child pays the QC participant, the proposer only gets its share
+	child := &types.Header{ParentHash: chain.CurrentBlock().Hash(), Number: big.NewInt(2), Coinbase: proposer}
+	statedb, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	assert.NoError(t, e.accumulateRewards(chain, statedb, child))
+	assert.Equal(t, big.NewInt(700), statedb.GetBalance(addr))
+	assert.Equal(t, big.NewInt(300), statedb.GetBalance(proposer))
+
+	// An unknown parent is an error instead of a reward to the proposer
+	child.ParentHash = common.Hash{0x01}
+	statedb, _ = state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	assert.Equal(t, consensus.ErrUnknownAncestor, e.accumulateRewards(chain, statedb, child))
+	assert.Equal(t, new(big.Int), statedb.GetBalance(proposer))
+}
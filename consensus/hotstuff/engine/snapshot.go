@@ -0,0 +1,403 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+)
+
+// Vote represents a single vote that an authorized validator made to modify the
+// list of validators.
+type Vote struct {
+	Validator common.Address `json:"validator"` // Authorized validator that cast this vote
+	Block     uint64         `json:"block"`     // Block number the vote was cast in (expire old votes)
+	Candidate common.Address `json:"candidate"` // Account being voted on to change its authorization
+	Authorize bool           `json:"authorize"` // Whether to authorize or deauthorize the voted account
+	PublicKey hexutil.Bytes  `json:"publicKey"` // BLS public key of the candidate if it is authorized
+}
+
+// Tally is a simple vote tally to keep the current score of votes. Votes that
+// go against the proposal aren't counted since it's equivalent to not voting.
+type Tally struct {
+	Authorize bool          `json:"authorize"` // Whether the vote is about authorizing or kicking someone
+	Votes     int           `json:"votes"`     // Number of votes until now wanting to pass the proposal
+	PublicKey hexutil.Bytes `json:"publicKey"` // BLS public key the authorizing votes agree on
+}
+
+// Snapshot is the state of the validator set and its voting at a given point in time.
+type Snapshot struct {
+	Epoch      uint64                           `json:"epoch"`      // The number of blocks between two checkpoints
+	Number     uint64                           `json:"number"`     // Block number where the snapshot was created
+	Hash       common.Hash                      `json:"hash"`       // Block hash where the snapshot was created
+	Validators []common.Address                 `json:"validators"` // Set of authorized validators at this moment, in ascending order
+	PublicKeys map[common.Address]hexutil.Bytes `json:"publicKeys"` // BLS public keys registered through the votes
+	Votes      []*Vote                          `json:"votes"`      // List of votes cast in chronological order
+	Tally      map[common.Address]Tally         `json:"tally"`      // Current vote tally to avoid recalculating
+	Pending    map[common.Address]bool          `json:"pending"`    // Passed changes taking effect at the next checkpoint
+	Staking    bool                             `json:"staking"`    // Whether the checkpoints record the validators elected by stake, instead of votes
+}
+
+// newSnapshot creates a new snapshot with the specified startup parameters. This
+// method is only ever used for the genesis block and the trusted checkpoints.
+func newSnapshot(epoch uint64, number uint64, hash common.Hash, validators []common.Address) *Snapshot {
+	snap := &Snapshot{
+		Epoch:      epoch,
+		Number:     number,
+		Hash:       hash,
+		Validators: make([]common.Address, len(validators)),
+		PublicKeys: make(map[common.Address]hexutil.Bytes),
+		Tally:      make(map[common.Address]Tally),
+		Pending:    make(map[common.Address]bool),
+	}
+	copy(snap.Validators, validators)
+	sortAddresses(snap.Validators)
+	return snap
+}
+
+// loadSnapshot loads an existing snapshot from the database.
+func loadSnapshot(db ethdb.Database, hash common.Hash) (*Snapshot, error) {
+	blob := rawdb.ReadHotstuffSnapshot(db, hash)
+	if len(blob) == 0 {
+		return nil, errUnknownSnapshot
+	}
+	snap := new(Snapshot)
+	if err := json.Unmarshal(blob, snap); err != nil {
+		return nil, err
+	}
+	return snap, nil
+}
+
+// store inserts the snapshot into the database.
+func (s *Snapshot) store(db ethdb.Database) error {
+	blob, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	rawdb.WriteHotstuffSnapshot(db, s.Hash, blob)
+	return nil
+}
+
+// copy creates a deep copy of the snapshot, though not the individual votes.
+func (s *Snapshot) copy() *Snapshot {
+	cpy := newSnapshot(s.Epoch, s.Number, s.Hash, s.Validators)
+	cpy.Staking = s.Staking
+	for addr, pubKey := range s.PublicKeys {
+		cpy.PublicKeys[addr] = pubKey
+	}
+	cpy.Votes = make([]*Vote, len(s.Votes))
+	copy(cpy.Votes, s.Votes)
+	for addr, tally := range s.Tally {
+		cpy.Tally[addr] = tally
+	}
+	for addr, authorize := range s.Pending {
+		cpy.Pending[addr] = authorize
+	}
+	return cpy
+}
+
+// validVote returns whether it makes sense to cast the specified vote in the
+// given snapshot context (e.g. don't try to add an already authorized validator,
+// or to remove the last validator).
+func (s *Snapshot) validVote(candidate common.Address, authorize bool) bool {
+	if _, pending := s.Pending[candidate]; pending {
+		return false
+	}
+	if authorize {
+		return !s.validator(candidate)
+	}
+	return s.validator(candidate) && len(s.nextValidators()) > 1
+}
+
+// cast adds a new vote into the tally.
+func (s *Snapshot) cast(candidate common.Address, authorize bool, pubKey []byte) bool {
+	// Ensure the vote is meaningful
+	if !s.validVote(candidate, authorize) {
+		return false
+	}
+	// Cast the vote into an existing or new tally
+	if old, ok := s.Tally[candidate]; ok {
+		// Authorizing votes only count if they agree on the key of the candidate
+		if authorize && !bytes.Equal(old.PublicKey, pubKey) {
+			return false
+		}
+		old.Votes++
+		s.Tally[candidate] = old
+	} else {
+		s.Tally[candidate] = Tally{Authorize: authorize, Votes: 1, PublicKey: pubKey}
+	}
+	return true
+}
+
+// uncast removes a previously cast vote from the tally.
+func (s *Snapshot) uncast(candidate common.Address, authorize bool) bool {
+	// If there's no tally, it's a dangling vote, just drop
+	tally, ok := s.Tally[candidate]
+	if !ok {
+		return false
+	}
+	// Ensure we only revert counted votes
+	if tally.Authorize != authorize {
+		return false
+	}
+	// Otherwise revert the vote
+	if tally.Votes > 1 {
+		tally.Votes--
+		s.Tally[candidate] = tally
+	} else {
+		delete(s.Tally, candidate)
+	}
+	return true
+}
+
+// apply creates a new authorization snapshot by applying the given headers to
+// the original one. Votes are tallied along the epoch, and the changes which
+// reached a 2f+1 majority take effect at the next checkpoint.
+func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
+	// Allow passing in no headers for cleaner code
+	if len(headers) == 0 {
+		return s, nil
+	}
+	// Sanity check that the headers can be applied
+	for i := 0; i < len(headers)-1; i++ {
+		if headers[i+1].Number.Uint64() != headers[i].Number.Uint64()+1 {
+			return nil, errInvalidVotingChain
+		}
+	}
+	if headers[0].Number.Uint64() != s.Number+1 {
+		return nil, errInvalidVotingChain
+	}
+	snap := s.copy()
+	for _, header := range headers {
+		// Checkpoint blocks enact the passed changes and reset the votes
+		number := header.Number.Uint64()
+		if number%s.Epoch == 0 {
+			if snap.Staking {
+				// The validators elected by stake are verified along the header
+				extra, err := types.ExtractHotstuffExtra(header)
+				if err != nil {
+					return nil, errInvalidExtraDataFormat
+				}
+				snap.enactElection(extra.Validators, extra.PublicKeys)
+			} else {
+				validators := snap.nextValidators()
+				for candidate, authorize := range snap.Pending {
+					if !authorize {
+						delete(snap.PublicKeys, candidate)
+					}
+				}
+				snap.Validators = validators
+			}
+			snap.Votes = nil
+			snap.Tally = make(map[common.Address]Tally)
+			snap.Pending = make(map[common.Address]bool)
+			continue
+		}
+		// Resolve the vote of the proposer, if any
+		if !snap.validator(header.Coinbase) {
+			return nil, errUnauthorized
+		}
+		extra, err := types.ExtractHotstuffExtra(header)
+		if err != nil {
+			return nil, errInvalidExtraDataFormat
+		}
+		// Validators proven to equivocate are removed at the next checkpoint
+		for _, evidence := range extra.Evidence {
+			snap.slash(evidence.Offender)
+		}
+		vote := extra.Vote
+		if vote == nil {
+			continue
+		}
+		// Discard any previous votes from the validator on the same candidate
+		for i, v := range snap.Votes {
+			if v.Validator == header.Coinbase && v.Candidate == vote.Candidate {
+				// Uncast the vote from the cached tally
+				snap.uncast(v.Candidate, v.Authorize)
+
+				// Uncast the vote from the chronological list
+				snap.Votes = append(snap.Votes[:i], snap.Votes[i+1:]...)
+				break // only one vote allowed
+			}
+		}
+		// Tally up the new vote from the validator
+		if snap.cast(vote.Candidate, vote.Authorize, vote.PublicKey) {
+			snap.Votes = append(snap.Votes, &Vote{
+				Validator: header.Coinbase,
+				Block:     number,
+				Candidate: vote.Candidate,
+				Authorize: vote.Authorize,
+				PublicKey: vote.PublicKey,
+			})
+		}
+		// If the vote passed, schedule the change for the next checkpoint
+		if tally := snap.Tally[vote.Candidate]; tally.Votes >= snap.quorum() {
+			snap.Pending[vote.Candidate] = tally.Authorize
+			if tally.Authorize {
+				snap.PublicKeys[vote.Candidate] = tally.PublicKey
+			}
+			// Discard any previous votes around the just changed account
+			snap.discardVotes(vote.Candidate)
+		}
+	}
+	snap.Number += uint64(len(headers))
+	snap.Hash = headers[len(headers)-1].Hash()
+
+	return snap, nil
+}
+
+// enactElection replaces the validators and their consensus keys with the ones
+// elected by stake.
+func (s *Snapshot) enactElection(validators []common.Address, pubKeys [][]byte) {
+	s.Validators = make([]common.Address, len(validators))
+	copy(s.Validators, validators)
+	sortAddresses(s.Validators)
+
+	s.PublicKeys = make(map[common.Address]hexutil.Bytes)
+	for i, addr := range validators {
+		if i < len(pubKeys) && len(pubKeys[i]) > 0 {
+			s.PublicKeys[addr] = common.CopyBytes(pubKeys[i])
+		}
+	}
+}
+
+// discardVotes drops the votes around an account whose change just passed.
+func (s *Snapshot) discardVotes(candidate common.Address) {
+	for i := 0; i < len(s.Votes); i++ {
+		if s.Votes[i].Candidate == candidate {
+			s.Votes = append(s.Votes[:i], s.Votes[i+1:]...)
+			i--
+		}
+	}
+	delete(s.Tally, candidate)
+}
+
+// slash schedules the removal of a validator proven to equivocate at the next
+// checkpoint, unless it is already leaving or is the last validator.
+func (s *Snapshot) slash(offender common.Address) bool {
+	if !s.validVote(offender, false) {
+		return false
+	}
+	s.Pending[offender] = false
+	s.discardVotes(offender)
+	return true
+}
+
+// nextValidators returns the validator set after enacting the pending changes,
+// in ascending order.
+func (s *Snapshot) nextValidators() []common.Address {
+	validators := make([]common.Address, 0, len(s.Validators)+len(s.Pending))
+	for _, addr := range s.Validators {
+		if authorize, ok := s.Pending[addr]; !ok || authorize {
+			validators = append(validators, addr)
+		}
+	}
+	for addr, authorize := range s.Pending {
+		if authorize {
+			validators = append(validators, addr)
+		}
+	}
+	sortAddresses(validators)
+	return validators
+}
+
+// quorum returns the number of votes needed to pass a proposal, that is 2f+1
+// of the validators.
+func (s *Snapshot) quorum() int {
+	return validator.NewSet(s.Validators, interfaces.RoundRobin).Q()
+}
+
+// ValSet builds a fresh validator set out of the snapshot, using the given
+// proposer election policy. The validators carry the consensus keys recorded
+// by the snapshot, which their seals are verified with.
+func (s *Snapshot) ValSet(policy interfaces.SelectProposerPolicy) interfaces.ValidatorSet {
+	pubKeys := make(map[common.Address][]byte, len(s.Validators))
+	for _, addr := range s.Validators {
+		pubKeys[addr] = s.PublicKeys[addr]
+	}
+	return validator.NewSetWithPublicKeys(s.Validators, pubKeys, policy)
+}
+
+// validator reports whether the given address is part of the validator set.
+func (s *Snapshot) validator(addr common.Address) bool {
+	index := sort.Search(len(s.Validators), func(i int) bool {
+		return bytes.Compare(s.Validators[i][:], addr[:]) >= 0
+	})
+	return index < len(s.Validators) && s.Validators[index] == addr
+}
+
+// checkpointValidators reports whether the given addresses are exactly the
+// validators the next checkpoint must record, regardless of their order.
+func (s *Snapshot) checkpointValidators(addrs []common.Address) bool {
+	next := s.nextValidators()
+	if len(addrs) != len(next) {
+		return false
+	}
+	sorted := make([]common.Address, len(addrs))
+	copy(sorted, addrs)
+	sortAddresses(sorted)
+	for i, addr := range sorted {
+		if addr != next[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// checkpointPublicKeys returns the consensus keys of the given validators, in the
+// same order, as recorded by a checkpoint. Unknown keys are left empty.
+func (s *Snapshot) checkpointPublicKeys(addrs []common.Address) [][]byte {
+	keys := make([][]byte, len(addrs))
+	for i, addr := range addrs {
+		keys[i] = common.CopyBytes(s.PublicKeys[addr])
+		if keys[i] == nil {
+			keys[i] = []byte{}
+		}
+	}
+	return keys
+}
+
+// equalPublicKeys reports whether the two lists hold the same keys in the same order.
+func equalPublicKeys(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// sortAddresses sorts the addresses in ascending byte order.
+func sortAddresses(addrs []common.Address) {
+	sort.Slice(addrs, func(i, j int) bool {
+		return bytes.Compare(addrs[i][:], addrs[j][:]) < 0
+	})
+}
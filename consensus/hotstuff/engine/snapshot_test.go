@@ -0,0 +1,229 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/staking"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	"github.com/stretchr/testify/assert"
+)
+
+// testBlock describes a header of a test chain.
+type testBlock struct {
+	proposer   common.Address
+	vote       *types.HotstuffVote
+	validators []common.Address // only for checkpoints
+	evidence   []*types.HotstuffEvidence
+}
+
+// testHeaders builds a chain of headers on top of the given parent.
+func testHeaders(t *testing.T, parent common.Hash, from uint64, blocks []testBlock) []*types.Header {
+	headers := make([]*types.Header, 0, len(blocks))
+	for i, block := range blocks {
+		header := &types.Header{
+			ParentHash: parent,
+			Number:     new(big.Int).SetUint64(from + uint64(i)),
+			Coinbase:   block.proposer,
+			Extra:      make([]byte, types.HotstuffExtraVanity),
+		}
+		if err := types.HotstuffHeaderFillWithValidators(header, block.validators); err != nil {
+			t.Fatalf("failed to fill header extra: %v", err)
+		}
+		if block.vote != nil {
+			if err := fillVote(header, block.vote); err != nil {
+				t.Fatalf("failed to fill header vote: %v", err)
+			}
+		}
+		if len(block.evidence) > 0 {
+			if err := fillEvidence(header, block.evidence); err != nil {
+				t.Fatalf("failed to fill header evidence: %v", err)
+			}
+		}
+		headers = append(headers, header)
+		parent = header.Hash()
+	}
+	return headers
+}
+
+func TestSnapshotVoting(t *testing.T) {
+	var (
+		a = common.HexToAddress("0x0a")
+		b = common.HexToAddress("0x0b")
+		c = common.HexToAddress("0x0c")
+		d = common.HexToAddress("0x0d")
+
+		key      = []byte{0x01}
+		otherKey = []byte{0x02}
+	)
+	genesis := newSnapshot(4, 0, common.Hash{}, []common.Address{c, b, a})
+	assert.Equal(t, []common.Address{a, b, c}, genesis.Validators)
+	assert.Equal(t, 2, genesis.quorum())
+
+	headers := testHeaders(t, genesis.Hash, 1, []testBlock{
+		{proposer: a, vote: &types.HotstuffVote{Candidate: d, Authorize: true, PublicKey: key}},
+		{proposer: b, vote: &types.HotstuffVote{Candidate: d, Authorize: true, PublicKey: otherKey}},
+		{proposer: c, vote: &types.HotstuffVote{Candidate: d, Authorize: true, PublicKey: key}},
+		{proposer: a, validators: []common.Address{a, b, c, d}},
+		{proposer: d, vote: &types.HotstuffVote{Candidate: a}},
+		{proposer: b, vote: &types.HotstuffVote{Candidate: a}},
+		{proposer: c, vote: &types.HotstuffVote{Candidate: a}},
+		{proposer: b, validators: []common.Address{b, c, d}},
+	})
+
+	// Votes disagreeing on the consensus key are not counted
+	snap, err := genesis.apply(headers[:2])
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(2), snap.Number)
+	assert.Equal(t, headers[1].Hash(), snap.Hash)
+	assert.Equal(t, 1, snap.Tally[d].Votes)
+	assert.Len(t, snap.Votes, 1)
+
+	// The passed change waits for the checkpoint
+	snap, err = snap.apply(headers[2:3])
+	assert.NoError(t, err)
+	assert.Equal(t, map[common.Address]bool{d: true}, snap.Pending)
+	assert.Equal(t, hexutil.Bytes(key), snap.PublicKeys[d])
+	assert.Empty(t, snap.Votes)
+	assert.False(t, snap.validator(d))
+	assert.False(t, snap.validVote(d, true))
+	assert.True(t, snap.checkpointValidators([]common.Address{d, c, b, a}))
+
+	// The checkpoint enacts the change and resets the votes
+	snap, err = snap.apply(headers[3:4])
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{a, b, c, d}, snap.Validators)
+	assert.Empty(t, snap.Pending)
+	assert.Equal(t, 3, snap.quorum())
+
+	// Validators are removed the same way
+	snap, err = snap.apply(headers[4:])
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{b, c, d}, snap.Validators)
+
+	// The original snapshot is left untouched
+	assert.Equal(t, []common.Address{a, b, c}, genesis.Validators)
+	assert.Empty(t, genesis.Tally)
+
+	// Non-contiguous headers are rejected
+	_, err = genesis.apply(headers[1:])
+	assert.Equal(t, errInvalidVotingChain, err)
+
+	// Blocks proposed by non validators are rejected
+	_, err = genesis.apply(testHeaders(t, genesis.Hash, 1, []testBlock{{proposer: d}}))
+	assert.Equal(t, errUnauthorized, err)
+}
+
+func TestSnapshotSlashing(t *testing.T) {
+	var (
+		a = common.HexToAddress("0x0a")
+		b = common.HexToAddress("0x0b")
+		c = common.HexToAddress("0x0c")
+		d = common.HexToAddress("0x0d")
+	)
+	genesis := newSnapshot(4, 0, common.Hash{}, []common.Address{a, b, c, d})
+	evidence := func(offender common.Address) []*types.HotstuffEvidence {
+		return []*types.HotstuffEvidence{{Offender: offender, First: []byte{0x01}, Second: []byte{0x02}}}
+	}
+	headers := testHeaders(t, genesis.Hash, 1, []testBlock{
+		{proposer: a, vote: &types.HotstuffVote{Candidate: b}},
+		{proposer: c, evidence: evidence(b)},
+		{proposer: d, evidence: evidence(b)},
+		{proposer: a, validators: []common.Address{a, c, d}},
+	})
+
+	// The offender is removed at the checkpoint and the votes about it dropped
+	snap, err := genesis.apply(headers[:3])
+	assert.NoError(t, err)
+	assert.Equal(t, map[common.Address]bool{b: false}, snap.Pending)
+	assert.Empty(t, snap.Votes)
+	assert.Empty(t, snap.Tally)
+	assert.False(t, snap.slash(b))
+
+	snap, err = snap.apply(headers[3:])
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{a, c, d}, snap.Validators)
+
+	// The last validator is never slashed
+	single := newSnapshot(4, 0, common.Hash{}, []common.Address{a})
+	assert.False(t, single.slash(a))
+	assert.False(t, genesis.copy().slash(common.HexToAddress("0x0e")))
+}
+
+func TestEvidenceEpoch(t *testing.T) {
+	// Evidences are bounded to the epoch of the block including them, so that
+	// they cannot slash a validator voted back in at a later checkpoint
+	tests := []struct {
+		number, first, last uint64
+	}{
+		{1, 1, 1},
+		{3, 1, 3},
+		{5, 5, 5},
+		{7, 5, 7},
+	}
+	for _, tt := range tests {
+		first, last := evidenceEpoch(tt.number, 4)
+		assert.Equal(t, tt.first, first, "block %d", tt.number)
+		assert.Equal(t, tt.last, last, "block %d", tt.number)
+	}
+}
+
+func TestSnapshotStore(t *testing.T) {
+	db := rawdb.NewMemoryDatabase()
+	snap := newSnapshot(30000, 1024, common.HexToHash("0x01"), []common.Address{common.HexToAddress("0x0a")})
+	snap.cast(common.HexToAddress("0x0b"), true, []byte{0x01})
+
+	_, err := loadSnapshot(db, snap.Hash)
+	assert.Equal(t, errUnknownSnapshot, err)
+
+	assert.NoError(t, snap.store(db))
+	loaded, err := loadSnapshot(db, snap.Hash)
+	assert.NoError(t, err)
+	assert.Equal(t, snap, loaded)
+}
+
+func TestVerifyVote(t *testing.T) {
+	var (
+		candidate = common.HexToAddress("0x0a")
+		sk, _     = blst.RandKey()
+		pubKey    = sk.PublicKey().Marshal()
+		proof     = staking.ProofOfPossession(sk, candidate)
+	)
+	assert.NoError(t, verifyVote(nil))
+	assert.NoError(t, verifyVote(&types.HotstuffVote{Candidate: candidate}))
+	assert.NoError(t, verifyVote(&types.HotstuffVote{Candidate: candidate, Authorize: true, PublicKey: pubKey, Proof: proof}))
+
+	// Keys must come with the proof the candidate owns them
+	assert.Equal(t, errInvalidVote, verifyVote(&types.HotstuffVote{Candidate: candidate, Authorize: true, PublicKey: pubKey}))
+	assert.Equal(t, errInvalidVote, verifyVote(&types.HotstuffVote{Candidate: common.HexToAddress("0x0b"), Authorize: true, PublicKey: pubKey, Proof: proof}))
+
+	// A rogue key, derived from the key of another validator, has no valid proof
+	other, _ := blst.RandKey()
+	rogue := other.PublicKey().Marshal()
+	assert.Equal(t, errInvalidVote, verifyVote(&types.HotstuffVote{Candidate: candidate, Authorize: true, PublicKey: rogue, Proof: proof}))
+
+	// Removals carry neither key nor proof
+	assert.Equal(t, errInvalidVote, verifyVote(&types.HotstuffVote{Candidate: candidate, Proof: proof}))
+}
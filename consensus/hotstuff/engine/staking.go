@@ -0,0 +1,153 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/staking"
+	core2 "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// stakingCallGas is the gas allowance of every call reading the staking contract.
+const stakingCallGas = 50000000
+
+// errMissingStakingState is returned if the state of the block preceding a
+// checkpoint is not available to elect its validators, as during a header sync.
+var errMissingStakingState = errors.New("missing state to elect validators")
+
+// stateChain is implemented by chains holding the state of their blocks, such
+// as core.BlockChain
+type stateChain interface {
+	consensus.ChainHeaderReader
+	Engine() consensus.Engine
+	StateAt(root common.Hash) (*state.StateDB, error)
+}
+
+// checkpointValidators returns the validators, along their consensus keys, the
+// checkpoint following the parent block must record.
+func (e *HotStuffEngine) checkpointValidators(chain consensus.ChainHeaderReader, parent *types.Header, snap *Snapshot) ([]common.Address, [][]byte, error) {
+	if !snap.Staking {
+		vals := snap.nextValidators()
+		return vals, snap.checkpointPublicKeys(vals), nil
+	}
+	return e.electValidators(chain, parent, snap)
+}
+
+// electValidators elects the validators of the checkpoint following the parent
+// block out of the candidates of the staking contract, in the parent state. The
+// validators slashed along the epoch are not elected, and the current ones keep
+// sealing if none of the candidates is.
+func (e *HotStuffEngine) electValidators(chain consensus.ChainHeaderReader, parent *types.Header, snap *Snapshot) ([]common.Address, [][]byte, error) {
+	conf := chain.Config().HotStuff.Staking
+	call, err := stakingCaller(chain, parent, conf.Contract)
+	if err != nil {
+		return nil, nil, err
+	}
+	candidates, err := staking.Candidates(call)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read staking candidates: %v", err)
+	}
+	eligible := make([]*staking.Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if authorize, ok := snap.Pending[c.Address]; ok && !authorize {
+			continue
+		}
+		eligible = append(eligible, c)
+	}
+	elected := staking.Elect(eligible, conf.MinStake, conf.MaxValidators)
+	if len(elected) == 0 {
+		e.logger.Warn("No staking candidate elected, keeping validators", "number", parent.Number.Uint64()+1)
+		vals := snap.nextValidators()
+		return vals, snap.checkpointPublicKeys(vals), nil
+	}
+	vals := make([]common.Address, len(elected))
+	keys := make([][]byte, len(elected))
+	for i, c := range elected {
+		vals[i], keys[i] = c.Address, c.PublicKey
+	}
+	return vals, keys, nil
+}
+
+// stakingCaller returns a caller of the staking contract executing the calls
+// through core.ApplyMessage against the state of the parent block.
+func stakingCaller(chain consensus.ChainHeaderReader, parent *types.Header, contract common.Address) (staking.Caller, error) {
+	sc, ok := chain.(stateChain)
+	if !ok {
+		return nil, errMissingStakingState
+	}
+	statedb, err := sc.StateAt(parent.Root)
+	if err != nil {
+		return nil, errMissingStakingState
+	}
+	// the calls are free, which leaves the tip paid to the coinbase at zero
+	blockCtx := core2.NewEVMBlockContext(parent, sc, &parent.Coinbase)
+	blockCtx.BaseFee = new(big.Int)
+	return func(input []byte) ([]byte, error) {
+		evm := vm.NewEVM(blockCtx, vm.TxContext{}, statedb, chain.Config(), vm.Config{NoBaseFee: true})
+		msg := types.NewMessage(common.Address{}, &contract, 0, new(big.Int), stakingCallGas, new(big.Int), new(big.Int), new(big.Int), input, nil, true)
+		result, err := core2.ApplyMessage(evm, msg, new(core2.GasPool).AddGas(stakingCallGas))
+		if err != nil {
+			return nil, err
+		}
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Return(), nil
+	}, nil
+}
+
+// verifyStakingCheckpoint checks that the checkpoint records the validators
+// elected by stake in the state of the parent block. Without that state the
+// election can't be replayed, and the checkpoint is trusted on the quorum of
+// the current validators sealing it.
+func (e *HotStuffEngine) verifyStakingCheckpoint(chain consensus.ChainHeaderReader, parent *types.Header, snap *Snapshot, extra *types.HotstuffExtra) error {
+	vals, keys, err := e.electValidators(chain, parent, snap)
+	if errors.Is(err, errMissingStakingState) {
+		if len(extra.Validators) == 0 {
+			return errInvalidCheckpointValidators
+		}
+		if len(extra.PublicKeys) != len(extra.Validators) {
+			return errInvalidCheckpointPublicKeys
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if len(extra.Validators) != len(vals) {
+		return errInvalidCheckpointValidators
+	}
+	for i, addr := range vals {
+		if extra.Validators[i] != addr {
+			return errInvalidCheckpointValidators
+		}
+	}
+	if !equalPublicKeys(extra.PublicKeys, keys) {
+		return errInvalidCheckpointPublicKeys
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/staking"
+	core2 "github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+	"github.com/stretchr/testify/assert"
+)
+
+// testCandidate is an account registering a consensus key and staking in the
+// staking contract of the genesis.
+type testCandidate struct {
+	addr  common.Address
+	key   blscommon.SecretKey
+	proof []byte
+	stake int64
+}
+
+// stakingGenesisAccount deploys the staking contract with the candidates
+// registered, by running their calls in a scratch state.
+func stakingGenesisAccount(t *testing.T, contract common.Address, candidates []*testCandidate) core2.GenesisAccount {
+	parsed, _ := abi.JSON(strings.NewReader(staking.ABI))
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	account := staking.GenesisAccount(10)
+	statedb.SetCode(contract, account.Code)
+	for key, value := range account.Storage {
+		statedb.SetState(contract, key, value)
+	}
+	blockCtx := vm.BlockContext{
+		CanTransfer: core2.CanTransfer,
+		Transfer:    core2.Transfer,
+		BlockNumber: new(big.Int),
+		Difficulty:  new(big.Int),
+	}
+	for _, c := range candidates {
+		statedb.AddBalance(c.addr, big.NewInt(c.stake))
+		register, _ := parsed.Pack("register", c.key.PublicKey().Marshal(), c.proof)
+		stake, _ := parsed.Pack("stake")
+		for _, call := range []struct {
+			input []byte
+			value *big.Int
+		}{{register, new(big.Int)}, {stake, big.NewInt(c.stake)}} {
+			evm := vm.NewEVM(blockCtx, vm.TxContext{}, statedb, params.AllEthashProtocolChanges, vm.Config{})
+			statedb.PrepareAccessList(c.addr, &contract, nil, nil)
+			if _, _, err := evm.Call(vm.AccountRef(c.addr), contract, call.input, 1000000, call.value); err != nil {
+				t.Fatalf("failed to call staking contract: %v", err)
+			}
+		}
+	}
+	statedb.Commit(true)
+
+	account.Storage = make(map[common.Hash]common.Hash)
+	statedb.ForEachStorage(contract, func(key, value common.Hash) bool {
+		account.Storage[key] = value
+		return true
+	})
+	account.Balance = statedb.GetBalance(contract)
+	return account
+}
+
+// Tests that checkpoints record the validators elected by the staking contract.
+func TestStakingElection(t *testing.T) {
+	var (
+		key, _   = crypto.GenerateKey()
+		sk, _    = blst.RandKey()
+		addr     = crypto.PubkeyToAddress(key.PublicKey)
+		contract = common.Address{0xbb}
+		db       = rawdb.NewMemoryDatabase()
+		genesis  = core2.DeveloperHotstuffGenesisBlock(1, addr, sk.PublicKey().Marshal(), addr)
+	)
+	var candidates []*testCandidate
+	for i, stake := range []int64{300, 200, 50, 400} {
+		c := &testCandidate{addr: common.BytesToAddress([]byte{byte(i + 1)}), stake: stake}
+		c.key, _ = blst.RandKey()
+		c.proof = staking.ProofOfPossession(c.key, c.addr)
+		candidates = append(candidates, c)
+	}
+	candidates[0].addr, candidates[0].key, candidates[0].proof = addr, sk, staking.ProofOfPossession(sk, addr)
+	candidates[3].proof = candidates[1].proof // the last one can't prove its key
+
+	genesis.Config.HotStuff.Epoch = 1
+	genesis.Config.HotStuff.Staking = &params.HotStuffStaking{Contract: contract, MinStake: big.NewInt(100)}
+	genesis.Alloc[contract] = stakingGenesisAccount(t, contract, candidates)
+	genesis.MustCommit(db)
+
+	e := New(key, &sk, config.FromChainConfig(genesis.Config.HotStuff), db).(*HotStuffEngine)
+	chain, err := core2.NewBlockChain(db, nil, genesis.Config, e, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	// The checkpoint records the candidates with enough stake and a proven key
+	parent := chain.CurrentBlock().Header()
+	header := &types.Header{
+		ParentHash: parent.Hash(),
+		Number:     big.NewInt(1),
+		GasLimit:   parent.GasLimit,
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+	}
+	assert.NoError(t, e.Prepare(chain, header))
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to extract extra: %v", err)
+	}
+	elected := []common.Address{candidates[1].addr, addr}
+	sortAddresses(elected)
+	assert.Equal(t, elected, extra.Validators)
+	for i, val := range extra.Validators {
+		if val == addr {
+			assert.Equal(t, sk.PublicKey().Marshal(), extra.PublicKeys[i])
+		} else {
+			assert.Equal(t, candidates[1].key.PublicKey().Marshal(), extra.PublicKeys[i])
+		}
+	}
+	snap, err := e.snapshot(chain, 0, parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve snapshot: %v", err)
+	}
+	assert.True(t, snap.Staking)
+	assert.NoError(t, e.verifyStakingCheckpoint(chain, parent, snap, extra))
+
+	// The checkpoint enacts the election
+	next, err := snap.apply([]*types.Header{header})
+	if err != nil {
+		t.Fatalf("failed to apply checkpoint: %v", err)
+	}
+	assert.Equal(t, elected, next.Validators)
+	assert.Equal(t, candidates[1].key.PublicKey().Marshal(), []byte(next.PublicKeys[candidates[1].addr]))
+
+	// Checkpoints deviating from the election are rejected
+	forged := *extra
+	forged.Validators = []common.Address{addr}
+	forged.PublicKeys = [][]byte{sk.PublicKey().Marshal()}
+	assert.Equal(t, errInvalidCheckpointValidators, e.verifyStakingCheckpoint(chain, parent, snap, &forged))
+
+	// Validators slashed along the epoch are not elected
+	slashed := snap.copy()
+	slashed.Validators = elected
+	slashed.Pending[candidates[1].addr] = false
+	vals, keys, err := e.electValidators(chain, parent, slashed)
+	assert.NoError(t, err)
+	assert.Equal(t, []common.Address{addr}, vals)
+	assert.Equal(t, [][]byte{sk.PublicKey().Marshal()}, keys)
+
+	// Without the parent state, the checkpoint is trusted on its quorum
+	headers, _ := core2.NewHeaderChain(db, genesis.Config, e, func() bool { return false })
+	assert.NoError(t, e.verifyStakingCheckpoint(headers, parent, snap, &forged))
+}
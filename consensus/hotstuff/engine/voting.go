@@ -0,0 +1,141 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"math/rand"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/staking"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Propose injects a new authorization proposal that the validator will attempt
+// to push through. An authorizing proposal must carry the BLS public key of the
+// candidate, and the proof the candidate owns it.
+func (e *HotStuffEngine) Propose(candidate common.Address, pubKey []byte, proof []byte, auth bool) error {
+	vote := &types.HotstuffVote{
+		Candidate: candidate,
+		Authorize: auth,
+	}
+	if auth {
+		vote.PublicKey = common.CopyBytes(pubKey)
+		vote.Proof = common.CopyBytes(proof)
+	}
+	if err := verifyVote(vote); err != nil {
+		return err
+	}
+	e.proposalsMu.Lock()
+	defer e.proposalsMu.Unlock()
+
+	e.proposals[candidate] = vote
+	return nil
+}
+
+// Discard drops a currently running proposal, stopping the validator from casting
+// further votes (either for or against).
+func (e *HotStuffEngine) Discard(candidate common.Address) {
+	e.proposalsMu.Lock()
+	defer e.proposalsMu.Unlock()
+
+	delete(e.proposals, candidate)
+}
+
+// Proposals returns the current proposals the node tries to uphold and vote on.
+func (e *HotStuffEngine) Proposals() map[common.Address]bool {
+	e.proposalsMu.RLock()
+	defer e.proposalsMu.RUnlock()
+
+	proposals := make(map[common.Address]bool)
+	for candidate, vote := range e.proposals {
+		proposals[candidate] = vote.Authorize
+	}
+	return proposals
+}
+
+// pickVote selects one of the proposals which makes sense to vote on in the
+// context of the given snapshot, if any.
+func (e *HotStuffEngine) pickVote(snap *Snapshot) *types.HotstuffVote {
+	e.proposalsMu.RLock()
+	defer e.proposalsMu.RUnlock()
+
+	candidates := make([]common.Address, 0, len(e.proposals))
+	for candidate, vote := range e.proposals {
+		if snap.validVote(candidate, vote.Authorize) {
+			candidates = append(candidates, candidate)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	vote := *e.proposals[candidates[rand.Intn(len(candidates))]]
+	return &vote
+}
+
+// fillVote records the proposer's vote in the extra-data of the header.
+func fillVote(header *types.Header, vote *types.HotstuffVote) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	extra.Vote = vote
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	return nil
+}
+
+// fillPublicKeys records the consensus keys of the checkpoint validators in the
+// extra-data of the header.
+func fillPublicKeys(header *types.Header, keys [][]byte) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	extra.PublicKeys = keys
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	return nil
+}
+
+// verifyVote checks that the vote carries the consensus key of the candidate
+// when it authorizes it, and none otherwise. The key must come with the proof
+// the candidate owns it, bound to its address like the keys registered in the
+// staking contract, or a rogue key could forge the aggregated seals.
+func verifyVote(vote *types.HotstuffVote) error {
+	if vote == nil {
+		return nil
+	}
+	if !vote.Authorize {
+		if len(vote.PublicKey) != 0 || len(vote.Proof) != 0 {
+			return errInvalidVote
+		}
+		return nil
+	}
+	if !staking.VerifyProofOfPossession(vote.Candidate, vote.PublicKey, vote.Proof) {
+		return errInvalidVote
+	}
+	return nil
+}
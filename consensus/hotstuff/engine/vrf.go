@@ -0,0 +1,142 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"encoding/binary"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// vrfProofLength is the length of the BLS signature proving the VRF output.
+const vrfProofLength = 96
+
+// The VRF proposer election works as follows: the proposer of a block signs the
+// parent hash and the round it proposes in with its BLS key, and records the
+// signature in the salt of the header. BLS signatures being unique, the hash of
+// the signature is a verifiable random output nobody can compute before the
+// proposer, which seeds the election of the proposers of the next height.
+
+// SealProposal seals the proposal of the local node for the given round. With
+// the VRF policy, the salt of the proposal is stamped with the proof of the
+// proposer's election in the round, and the proposal signed again.
+func (e *HotStuffEngine) SealProposal(proposal interfaces.Proposal, round uint64) (interfaces.Proposal, error) {
+	block, ok := proposal.(*types.Block)
+	if !ok {
+		return nil, errInvalidProposal
+	}
+	if e.policy(e.chain) != interfaces.VRF {
+		return block, nil
+	}
+	header := block.Header()
+	proof, err := e.signer.BlsSigner.SignVRF(header.ParentHash, round)
+	if err != nil {
+		return nil, err
+	}
+	if err := fillSalt(header, encodeSalt(round, proof)); err != nil {
+		return nil, err
+	}
+	if err := e.signer.EthSigner.SealBeforeCommit(header); err != nil {
+		return nil, err
+	}
+	return block.WithSeal(header), nil
+}
+
+// policy returns the proposer election policy, as set in the chain config,
+// falling back to the engine config.
+func (e *HotStuffEngine) policy(chain consensus.ChainHeaderReader) interfaces.SelectProposerPolicy {
+	if chain != nil {
+		if conf := chain.Config().HotStuff; conf != nil {
+			return interfaces.SelectProposerPolicy(conf.ElectPolicy)
+		}
+	}
+	return e.config.LeaderPolicy
+}
+
+// verifyElection checks that the proposer of the header was elected in the round
+// recorded in its salt, and that the VRF proof of the salt is valid.
+func (e *HotStuffEngine) verifyElection(header, parent *types.Header, valSet interfaces.ValidatorSet) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return errInvalidExtraDataFormat
+	}
+	round, proof, err := decodeSalt(extra.Salt)
+	if err != nil {
+		return err
+	}
+	valSet.SetSeed(vrfSeed(parent))
+	valSet.CalcProposer(parent.Coinbase, round)
+	if !valSet.IsProposer(header.Coinbase) {
+		return errInvalidProposer
+	}
+	if err := e.signer.BlsSigner.VerifySignature(valSet, header.Coinbase, proof, core.VRFMessage(parent.Hash(), round)); err != nil {
+		return errInvalidVRFProof
+	}
+	return nil
+}
+
+// vrfSeed returns the randomness electing the proposers of the children of the
+// given header, that is the VRF output of its proposer. Blocks without a VRF
+// proof, such as the genesis block, seed the election with their hash.
+func vrfSeed(header *types.Header) common.Hash {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return header.Hash()
+	}
+	if _, proof, err := decodeSalt(extra.Salt); err == nil {
+		return crypto.Keccak256Hash(proof)
+	}
+	return header.Hash()
+}
+
+// encodeSalt packs the round and the VRF proof of the proposer into a salt.
+func encodeSalt(round uint64, proof []byte) []byte {
+	salt := make([]byte, 8, 8+len(proof))
+	binary.BigEndian.PutUint64(salt, round)
+	return append(salt, proof...)
+}
+
+// decodeSalt unpacks the round and the VRF proof of the proposer from a salt.
+func decodeSalt(salt []byte) (uint64, []byte, error) {
+	if len(salt) != 8+vrfProofLength {
+		return 0, nil, errInvalidVRFProof
+	}
+	return binary.BigEndian.Uint64(salt[:8]), salt[8:], nil
+}
+
+// fillSalt records the salt in the extra-data of the header.
+func fillSalt(header *types.Header, salt []byte) error {
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return err
+	}
+	extra.Salt = salt
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return err
+	}
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity:types.HotstuffExtraVanity], payload...)
+	return nil
+}
@@ -0,0 +1,98 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package engine
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVRFElection(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	sk, _ := blst.RandKey()
+	conf := *config.DefaultBasicConfig
+	conf.LeaderPolicy = interfaces.VRF
+	e := New(key, &sk, &conf, rawdb.NewMemoryDatabase()).(*HotStuffEngine)
+
+	addrs := []common.Address{e.Address()}
+	for i := 0; i < 3; i++ {
+		addrs = append(addrs, common.BytesToAddress([]byte{byte(i + 1)}))
+	}
+	pubKeys := map[common.Address][]byte{e.Address(): sk.PublicKey().Marshal()}
+	valSet := validator.NewSetWithPublicKeys(addrs, pubKeys, interfaces.VRF)
+
+	parent := &types.Header{Number: common.Big0}
+	assert.NoError(t, types.HotstuffHeaderFillWithValidators(parent, addrs))
+
+	// Find the rounds in which the engine is elected, and in which it is not
+	var elected, other = -1, -1
+	valSet.SetSeed(vrfSeed(parent))
+	for round := 0; round < 64 && (elected < 0 || other < 0); round++ {
+		valSet.CalcProposer(common.Address{}, uint64(round))
+		if valSet.IsProposer(e.Address()) {
+			elected = round
+		} else {
+			other = round
+		}
+	}
+	if elected < 0 || other < 0 {
+		t.Fatal("failed to find test rounds")
+	}
+	propose := func(round int) *types.Header {
+		header := &types.Header{
+			ParentHash: parent.Hash(),
+			Number:     big.NewInt(1),
+			Coinbase:   e.Address(),
+		}
+		assert.NoError(t, types.HotstuffHeaderFillWithValidators(header, nil))
+		assert.NoError(t, e.signer.EthSigner.SealBeforeCommit(header))
+		sealed, err := e.SealProposal(types.NewBlockWithHeader(header), uint64(round))
+		assert.NoError(t, err)
+		return sealed.(*types.Block).Header()
+	}
+	header := propose(elected)
+	assert.NoError(t, e.verifyElection(header, parent, valSet.Copy()))
+	assert.NoError(t, e.signer.EthSigner.VerifyLeaderSeal(header))
+
+	// Restamping a proposal keeps its identity
+	assert.Equal(t, proposalHash(header), proposalHash(propose(other)))
+
+	// Proposers elected in other rounds are rejected
+	assert.Equal(t, errInvalidProposer, e.verifyElection(propose(other), parent, valSet.Copy()))
+
+	// Proofs of other rounds are rejected
+	extra, _ := types.ExtractHotstuffExtra(header)
+	_, proof, _ := decodeSalt(extra.Salt)
+	forged := types.CopyHeader(header)
+	assert.NoError(t, fillSalt(forged, encodeSalt(uint64(elected), make([]byte, len(proof)))))
+	assert.Equal(t, errInvalidVRFProof, e.verifyElection(forged, parent, valSet.Copy()))
+
+	// The VRF output seeds the next election
+	assert.Equal(t, crypto.Keccak256Hash(proof), vrfSeed(header))
+}
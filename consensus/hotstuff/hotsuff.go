@@ -4,6 +4,7 @@ import (
 	"crypto/ecdsa"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
 	hotstuffEngine "github.com/ethereum/go-ethereum/consensus/hotstuff/engine"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
@@ -12,3 +13,9 @@ import (
 func New(privateKey *ecdsa.PrivateKey, consensusKey *common.SecretKey, config *config.Config, db ethdb.Database) consensus.Hotstuff {
 	return hotstuffEngine.New(privateKey, consensusKey, config, db)
 }
+
+// NewWithRemoteSigner creates a hotstuff engine signing with the keys held by
+// the remote signer, such as clef.
+func NewWithRemoteSigner(remote core.RemoteSigner, config *config.Config, db ethdb.Database) (consensus.Hotstuff, error) {
+	return hotstuffEngine.NewWithRemoteSigner(remote, config, db)
+}
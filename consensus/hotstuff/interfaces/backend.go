@@ -42,14 +42,25 @@ type Backend interface {
 	// Unicast send a message to single peer
 	Unicast(valSet ValidatorSet, payload []byte) error
 
-	// PreCommit write seal to header and assemble new qc
-	PreCommit(proposal Proposal, seals [][]byte) (Proposal, error)
+	// SealProposal seals the proposal of the local node for the given round, in
+	// which it is the proposer
+	SealProposal(proposal Proposal, round uint64) (Proposal, error)
+
+	// PreCommit write the aggregated seal of the participants, and the round of the
+	// view they voted in, to header and assemble new qc
+	PreCommit(proposal Proposal, round uint64, participants []common.Address, aggregatedSeal []byte) (Proposal, error)
 
 	// ForwardCommit assemble unsealed block and sealed extra into an new full block
 	ForwardCommit(proposal Proposal, extra []byte) (Proposal, error)
 
+	// Certify delivers a proposal certified by a generic QC in the chained mode to
+	// backend, which stores it off the canonical chain and extends it with the next
+	// proposals. The proposal is not canonical nor final until it is committed.
+	Certify(proposal Proposal) error
+
 	// Commit delivers an approved proposal to backend.
-	// The delivered proposal will be put into blockchain.
+	// The delivered proposal will be put into blockchain, or made canonical and
+	// finalized in the chained mode, where it is stored since it was certified.
 	Commit(proposal Proposal) error
 
 	// Verify verifies the proposal. If a consensus.ErrFutureBlock error is returned,
@@ -60,14 +71,27 @@ type Backend interface {
 	// the time difference of the proposal and current time is also returned.
 	VerifyUnsealedProposal(Proposal) (time.Duration, error)
 
-	// LastProposal retrieves latest committed proposal and the address of proposer
+	// LastProposal retrieves latest committed proposal and the address of proposer,
+	// or the latest certified one in the chained mode
 	LastProposal() (Proposal, common.Address)
 
 	// HasBadBlock returns whether the block with the hash is a bad block
 	HasBadProposal(hash common.Hash) bool
 
-	// ValidateBlock execute block which contained in prepare message, and validate block state
+	// ValidateBlock execute block which contained in prepare message, and validate block state.
+	// The backend may keep the resulting state to commit the block without executing it again.
 	ValidateBlock(block *types.Block) error
 
+	// AddEvidence hands a verified equivocation evidence to the backend, to be
+	// included in a block and slash its offender
+	AddEvidence(evidence *types.HotstuffEvidence)
+
+	// Validators returns the validator set which is responsible for the block at the given height
+	Validators(number uint64) ValidatorSet
+
+	// NextValidators returns the validator set which is responsible for the child
+	// of the given proposal, which may not be committed yet
+	NextValidators(proposal Proposal) ValidatorSet
+
 	Close() error
 }
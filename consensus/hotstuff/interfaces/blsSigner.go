@@ -30,8 +30,7 @@ type BlsSigner interface {
 	FastAggregateVerify(pubKeys []common.PublicKey, hash common2.Hash) bool
 	Marshal() []byte
 	ConsenesusKeyFromBytes(priv []byte) (err error)
-	StoreConsensusPublicKeyList(pubKeys []common.PublicKey)
-	GetConsensusPublicKey(keyIndex int) ([]byte, error)
-	StoreConsensusPublicKey(k []byte, pubKey []byte)
-	VerifyValidatorSeal(header *types.Header, valSet ValidatorSet) error
+	VerifyValidatorSeal(header *types.Header, valSet ValidatorSet, chained bool) error
+	VerifySignature(valSet ValidatorSet, addr common2.Address, sig []byte, msg common2.Hash) error
+	VerifyAggregatedSignature(valSet ValidatorSet, addrs []common2.Address, sig []byte, msg common2.Hash) error
 }
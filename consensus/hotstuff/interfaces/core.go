@@ -1,6 +1,8 @@
 package interfaces
 
 import (
+	"math/big"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 )
@@ -20,4 +22,8 @@ type HotstuffCore interface {
 	// pending request is populated right at the request stage so this would give us the earliest verification
 	// to avoid any race condition of coming propagated blocks
 	IsCurrentProposal(blockHash common.Hash) bool
+
+	// CurrentView returns the height and round the core is working on and the phase
+	// reached in that round, ok is false if the core is not running
+	CurrentView() (height *big.Int, round *big.Int, phase string, ok bool)
 }
@@ -28,6 +28,4 @@ type EthSigner interface {
 	SigHash(header *types.Header) (hash common.Hash)
 	Sign(data []byte) ([]byte, error)
 	SealBeforeCommit(h *types.Header) error
-	StoreValidator(val common.Address, valIndex int)
-	GetValidator(valIndex int) (common.Address, error)
 }
@@ -36,6 +36,9 @@ type Validator interface {
 	// Address returns address
 	Address() common.Address
 
+	// PublicKey returns the BLS consensus key of the validator, if known
+	PublicKey() []byte
+
 	// String representation of Validator
 	String() string
 }
@@ -93,6 +96,10 @@ type ValidatorSet interface {
 	Q() int
 	// Get speaker policy
 	Policy() SelectProposerPolicy
+	// Get the randomness the VRF policy elects the proposer with
+	Seed() common.Hash
+	// Set the randomness the VRF policy elects the proposer with
+	SetSeed(seed common.Hash)
 	// Cmp compare with another validator set, return false if not the same
 	Cmp(src ValidatorSet) bool
 }
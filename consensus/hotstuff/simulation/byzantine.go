@@ -0,0 +1,68 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simulation
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// Byzantine rewrites a message a validator sends to another one, returning the
+// message to deliver instead or nil to withhold it. Rewritten messages must be
+// signed again with Node.Sign.
+type Byzantine func(node *Node, to common.Address, msg *core.Message) *core.Message
+
+// Silent withholds every message of the validator, which keeps processing the
+// messages of the others.
+func Silent() Byzantine {
+	return func(node *Node, to common.Address, msg *core.Message) *core.Message {
+		return nil
+	}
+}
+
+// Equivocate makes the validator propose a different block to every other
+// validator of the network, splitting the votes of the honest ones.
+func Equivocate() Byzantine {
+	return func(node *Node, to common.Address, msg *core.Message) *core.Message {
+		if msg.Code != core.MsgTypePrepare {
+			return msg
+		}
+		var prepare core.MsgPrepare
+		if err := msg.Decode(&prepare); err != nil {
+			return msg
+		}
+		// Tag the proposal with the recipient, keeping it valid on the same parent
+		header := prepare.Proposal.Header()
+		copy(header.Extra, to.Bytes())
+		prepare.Proposal = prepare.Proposal.WithSeal(header)
+
+		payload, err := rlp.EncodeToBytes(&prepare)
+		if err != nil {
+			return msg
+		}
+		msg.Msg = payload
+		if err := node.Sign(msg); err != nil {
+			log.Warn("Failed to sign equivocated proposal", "err", err)
+			return nil
+		}
+		return msg
+	}
+}
@@ -0,0 +1,601 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package simulation runs networks of in-memory hotstuff validators, wired
+// together through simulated backends whose message delivery can be delayed,
+// dropped, reordered, partitioned or tampered with by Byzantine validators.
+package simulation
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	hsevent "github.com/ethereum/go-ethereum/consensus/hotstuff/event"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/validator"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+)
+
+// minePeriod is the interval at which the simulated miners submit their block
+// for the next height to the consensus cores.
+const minePeriod = 20 * time.Millisecond
+
+var (
+	// errNetworkClosed is returned when waiting on a network already stopped.
+	errNetworkClosed = errors.New("network closed")
+
+	// errTimeout is returned if the network doesn't reach the height in time.
+	errTimeout = errors.New("timeout")
+)
+
+// Network is a set of validators running the hotstuff consensus core with each
+// other, exchanging messages in memory.
+type Network struct {
+	nodes   []*Node
+	addrs   []common.Address
+	pubKeys map[common.Address][]byte // Consensus public keys of the validators
+	genesis *types.Block
+	config  *config.Config
+
+	router Router
+	lock   sync.RWMutex
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewNetwork creates a network of n validators running the given consensus
+// configuration, all of them knowing the consensus public keys of the others.
+func NewNetwork(n int, conf *config.Config) (*Network, error) {
+	net := &Network{
+		pubKeys: make(map[common.Address][]byte),
+		genesis: types.NewBlockWithHeader(&types.Header{Number: common.Big0}),
+		config:  conf,
+		quit:    make(chan struct{}),
+	}
+	for i := 0; i < n; i++ {
+		key, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		consensusKey, err := blst.RandKey()
+		if err != nil {
+			return nil, err
+		}
+		db := rawdb.NewMemoryDatabase()
+		node := &Node{
+			net:     net,
+			key:     key,
+			address: crypto.PubkeyToAddress(key.PublicKey),
+			mux:     new(event.TypeMux),
+			head:    net.genesis,
+			signer: &core.Signer{
+				EthSigner: core.NewEthSigner(key, db),
+				BlsSigner: core.NewBlsSigner(&consensusKey, db),
+			},
+		}
+		node.core = core.New(node, conf, node.signer, db)
+
+		net.nodes = append(net.nodes, node)
+		net.addrs = append(net.addrs, node.address)
+		net.pubKeys[node.address] = consensusKey.PublicKey().Marshal()
+	}
+	return net, nil
+}
+
+// Nodes returns the validators of the network.
+func (net *Network) Nodes() []*Node {
+	return net.nodes
+}
+
+// Genesis returns the block all validators start from.
+func (net *Network) Genesis() *types.Block {
+	return net.genesis
+}
+
+// SetRouter replaces the policy delivering the messages between validators,
+// nil delivering all of them immediately.
+func (net *Network) SetRouter(router Router) {
+	net.lock.Lock()
+	defer net.lock.Unlock()
+	net.router = router
+}
+
+// Start starts the consensus core and the miner of every validator.
+func (net *Network) Start() error {
+	for _, node := range net.nodes {
+		if err := node.Start(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Stop stops all validators and drops the messages still in flight.
+func (net *Network) Stop() {
+	close(net.quit)
+	for _, node := range net.nodes {
+		node.Stop()
+	}
+	net.wg.Wait()
+}
+
+// WaitHeight waits until every running honest validator has committed the
+// block of the given height, which is final in both protocols.
+func (net *Network) WaitHeight(height uint64, timeout time.Duration) error {
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+
+	for {
+		reached := true
+		for _, node := range net.nodes {
+			if node.Running() && node.Byzantine() == nil && node.committedHeight() < height {
+				reached = false
+				break
+			}
+		}
+		if reached {
+			return nil
+		}
+		select {
+		case <-ticker.C:
+		case <-deadline.C:
+			return fmt.Errorf("%w waiting for height %d", errTimeout, height)
+		case <-net.quit:
+			return errNetworkClosed
+		}
+	}
+}
+
+// CheckSafety verifies that no two honest validators committed different
+// blocks at the same height, and that every one of them committed a chain.
+func (net *Network) CheckSafety() error {
+	committed := make(map[uint64]*types.Block)
+	for _, node := range net.nodes {
+		if node.Byzantine() != nil {
+			continue
+		}
+		parent := net.genesis
+		for _, block := range node.Committed() {
+			if block.NumberU64() != parent.NumberU64()+1 || block.ParentHash() != parent.Hash() {
+				return fmt.Errorf("validator %v committed block %d %v on top of %d %v", node.address, block.NumberU64(), block.Hash(), parent.NumberU64(), parent.Hash())
+			}
+			if other, ok := committed[block.NumberU64()]; ok && other.Hash() != block.Hash() {
+				return fmt.Errorf("conflicting commits at height %d: %v and %v", block.NumberU64(), other.Hash(), block.Hash())
+			}
+			committed[block.NumberU64()] = block
+			parent = block
+		}
+	}
+	return nil
+}
+
+// send delivers a message of the sender to the recipients, through the
+// Byzantine behaviour of the sender and the router of the network.
+func (net *Network) send(from *Node, recipients []*Node, payload []byte) {
+	net.lock.RLock()
+	router := net.router
+	net.lock.RUnlock()
+
+	byzantine := from.Byzantine()
+	for _, to := range recipients {
+		msg := new(core.Message)
+		if err := rlp.DecodeBytes(payload, msg); err != nil {
+			return
+		}
+		out := payload
+		if byzantine != nil && to != from {
+			if msg = byzantine(from, to.address, msg); msg == nil {
+				continue
+			}
+			var err error
+			if out, err = msg.Payload(); err != nil {
+				continue
+			}
+		}
+		var delay time.Duration
+		if router != nil && to != from {
+			var deliver bool
+			if delay, deliver = router(from.address, to.address, msg); !deliver {
+				continue
+			}
+		}
+		net.deliver(to, out, delay)
+	}
+}
+
+// deliver posts the message to the recipient after the delay, unless the
+// network is stopped in the meantime.
+func (net *Network) deliver(to *Node, payload []byte, delay time.Duration) {
+	net.wg.Add(1)
+	go func() {
+		defer net.wg.Done()
+
+		timer := time.NewTimer(delay)
+		defer timer.Stop()
+
+		select {
+		case <-timer.C:
+			if to.Running() {
+				to.mux.Post(hsevent.MessageEvent{Payload: payload})
+			}
+		case <-net.quit:
+		}
+	}()
+}
+
+// Node is a validator of a simulated network. It implements the backend of its
+// consensus core, writing blocks to an in-memory chain. In the chained mode the
+// blocks are written once certified, and committed later.
+type Node struct {
+	net     *Network
+	key     *ecdsa.PrivateKey
+	address common.Address
+	mux     *event.TypeMux
+	signer  *core.Signer
+	core    *core.Core
+
+	lock      sync.Mutex
+	head      *types.Block
+	chain     []*types.Block // blocks of the local chain above the genesis
+	committed []*types.Block
+	evidence  []*types.HotstuffEvidence
+	byzantine Byzantine
+	running   bool
+	stop      chan struct{}
+}
+
+// Core returns the consensus core of the validator.
+func (n *Node) Core() *core.Core { return n.core }
+
+// Head returns the head of the chain of the validator, which is only certified
+// in the chained mode.
+func (n *Node) Head() *types.Block {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.head
+}
+
+// Committed returns the blocks committed by the validator, in order.
+func (n *Node) Committed() []*types.Block {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]*types.Block{}, n.committed...)
+}
+
+// committedHeight returns the height of the last block committed by the validator.
+func (n *Node) committedHeight() uint64 {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return uint64(len(n.committed))
+}
+
+// blocks returns the blocks of the chain of the validator, in order.
+func (n *Node) blocks() []*types.Block {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]*types.Block{}, n.chain...)
+}
+
+// Evidence returns the equivocations reported by the consensus core.
+func (n *Node) Evidence() []*types.HotstuffEvidence {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return append([]*types.HotstuffEvidence{}, n.evidence...)
+}
+
+// SetByzantine makes the validator tamper with the messages it sends, nil
+// restoring its honest behaviour.
+func (n *Node) SetByzantine(byzantine Byzantine) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.byzantine = byzantine
+}
+
+// Byzantine returns the behaviour of the validator, nil if honest.
+func (n *Node) Byzantine() Byzantine {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.byzantine
+}
+
+// Running reports whether the validator is started.
+func (n *Node) Running() bool {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	return n.running
+}
+
+// Start starts the consensus core of the validator, resuming its last votes
+// and locks if it ran before, and the miner submitting blocks to it.
+func (n *Node) Start() error {
+	if err := n.core.Start(nil); err != nil {
+		return err
+	}
+	stop := make(chan struct{})
+	n.lock.Lock()
+	n.running, n.stop = true, stop
+	n.lock.Unlock()
+
+	n.net.wg.Add(1)
+	go n.mine(stop)
+	return nil
+}
+
+// Stop crashes the validator: its core and miner are stopped and it doesn't
+// receive any message until restarted.
+func (n *Node) Stop() error {
+	if err := n.core.Stop(); err != nil {
+		return err
+	}
+	n.lock.Lock()
+	close(n.stop)
+	n.running = false
+	n.lock.Unlock()
+	return nil
+}
+
+// Sign signs the message as the validator, to forward tampered messages.
+func (n *Node) Sign(msg *core.Message) error {
+	msg.Address = n.address
+	data, err := msg.PayloadNoSig()
+	if err != nil {
+		return err
+	}
+	msg.Signature, err = n.signer.EthSigner.Sign(data)
+	return err
+}
+
+// sync imports the blocks of the chains of the other validators above the local
+// head, like the downloader does for a validator left behind, as far as the
+// router lets the validators reach each other.
+func (n *Node) sync() {
+	n.net.lock.RLock()
+	router := n.net.router
+	n.net.lock.RUnlock()
+
+	head := n.Head()
+	for _, peer := range n.net.nodes {
+		if peer == n || !peer.Running() || peer.Head().NumberU64() <= head.NumberU64() {
+			continue
+		}
+		if router != nil {
+			if _, deliver := router(peer.address, n.address, nil); !deliver {
+				continue
+			}
+		}
+		var blocks []*types.Block
+		for _, block := range peer.blocks() {
+			if block.NumberU64() > head.NumberU64() {
+				blocks = append(blocks, block)
+			}
+		}
+		if len(blocks) == 0 || blocks[0].ParentHash() != head.Hash() {
+			continue
+		}
+		for _, block := range blocks {
+			if n.net.config.Chained {
+				n.Certify(block)
+			} else {
+				n.Commit(block)
+			}
+		}
+		return
+	}
+}
+
+// mine submits the block of the validator for the height above its head to
+// the consensus core, again and again like the miner does on new work.
+func (n *Node) mine(stop chan struct{}) {
+	defer n.net.wg.Done()
+
+	ticker := time.NewTicker(minePeriod)
+	defer ticker.Stop()
+
+	var block *types.Block
+	for {
+		n.sync()
+		if head := n.Head(); block == nil || block.ParentHash() != head.Hash() {
+			header := &types.Header{
+				Number:     new(big.Int).Add(head.Number(), common.Big1),
+				ParentHash: head.Hash(),
+				Coinbase:   n.address,
+			}
+			types.HotstuffHeaderFillWithValidators(header, nil)
+			block = types.NewBlockWithHeader(header)
+		}
+		n.mux.Post(hsevent.RequestEvent{Proposal: block})
+
+		select {
+		case <-ticker.C:
+		case <-stop:
+			return
+		case <-n.net.quit:
+			return
+		}
+	}
+}
+
+func (n *Node) Address() common.Address          { return n.address }
+func (n *Node) EventMux() *event.TypeMux         { return n.mux }
+func (n *Node) HasBadProposal(common.Hash) bool  { return false }
+func (n *Node) ValidateBlock(*types.Block) error { return nil }
+func (n *Node) Close() error                     { return nil }
+
+// Broadcast implements interfaces.Backend.Broadcast, sending to all validators
+// the local one included.
+func (n *Node) Broadcast(valSet interfaces.ValidatorSet, payload []byte) error {
+	n.net.send(n, n.net.nodes, payload)
+	return nil
+}
+
+// Gossip implements interfaces.Backend.Gossip, sending to all other validators.
+func (n *Node) Gossip(valSet interfaces.ValidatorSet, payload []byte) error {
+	recipients := make([]*Node, 0, len(n.net.nodes)-1)
+	for _, node := range n.net.nodes {
+		if node != n {
+			recipients = append(recipients, node)
+		}
+	}
+	n.net.send(n, recipients, payload)
+	return nil
+}
+
+// Unicast implements interfaces.Backend.Unicast, sending to the proposer of
+// the validator set.
+func (n *Node) Unicast(valSet interfaces.ValidatorSet, payload []byte) error {
+	var recipients []*Node
+	for _, node := range n.net.nodes {
+		if valSet.IsProposer(node.address) {
+			recipients = append(recipients, node)
+		}
+	}
+	n.net.send(n, recipients, payload)
+	return nil
+}
+
+func (n *Node) SealProposal(proposal interfaces.Proposal, round uint64) (interfaces.Proposal, error) {
+	return proposal, nil
+}
+
+// PreCommit implements interfaces.Backend.PreCommit, writing the participants,
+// the aggregated seal and the round of the QC to the extra-data of the block like
+// the engine does, so that the QC travels with the synced blocks.
+func (n *Node) PreCommit(proposal interfaces.Proposal, round uint64, participants []common.Address, aggregatedSeal []byte) (interfaces.Proposal, error) {
+	block := proposal.(*types.Block)
+	header := block.Header()
+	extra, err := types.ExtractHotstuffExtra(header)
+	if err != nil {
+		return nil, err
+	}
+	valSet := n.Validators(block.NumberU64())
+	extra.Participants = types.NewHotstuffBitmap(valSet.Size())
+	for _, addr := range participants {
+		index, val := valSet.GetByAddress(addr)
+		if val == nil {
+			return nil, fmt.Errorf("unknown participant %v", addr)
+		}
+		extra.Participants.Set(index)
+	}
+	extra.AggregatedValidatorsSeal = aggregatedSeal
+	extra.Round = round
+
+	payload, err := rlp.EncodeToBytes(&extra)
+	if err != nil {
+		return nil, err
+	}
+	header.Extra = append(header.Extra[:types.HotstuffExtraVanity], payload...)
+	return block.WithSeal(header), nil
+}
+
+func (n *Node) ForwardCommit(proposal interfaces.Proposal, extra []byte) (interfaces.Proposal, error) {
+	return proposal, nil
+}
+
+// Certify implements interfaces.Backend.Certify, making the block the head of
+// the chain of the validator, which drops the blocks of another branch.
+func (n *Node) Certify(proposal interfaces.Proposal) error {
+	block := proposal.(*types.Block)
+
+	n.lock.Lock()
+	n.write(block)
+	n.lock.Unlock()
+
+	go n.mux.Post(hsevent.FinalCommittedEvent{Header: block.Header()})
+	return nil
+}
+
+// Commit implements interfaces.Backend.Commit, appending the block to the
+// chain of the validator unless it was already synced. In the chained mode the
+// block is already in the chain, and it is committed along with the ancestors
+// the validator didn't commit itself, like the ones synced.
+func (n *Node) Commit(proposal interfaces.Proposal) error {
+	block := proposal.(*types.Block)
+
+	n.lock.Lock()
+	defer n.lock.Unlock()
+
+	number := int(block.NumberU64())
+	if n.net.config.Chained {
+		for i := len(n.committed); i < number-1 && i < len(n.chain); i++ {
+			n.committed = append(n.committed, n.chain[i])
+		}
+		if number > len(n.committed) {
+			n.committed = append(n.committed, block)
+		}
+		return nil
+	}
+	// Skip the blocks already synced, recording conflicting ones
+	if number <= len(n.committed) && n.committed[number-1].Hash() == block.Hash() {
+		return nil
+	}
+	n.write(block)
+	n.committed = append(n.committed, block)
+
+	go n.mux.Post(hsevent.FinalCommittedEvent{Header: block.Header()})
+	return nil
+}
+
+// write makes the block the head of the chain, on top of its ancestors.
+func (n *Node) write(block *types.Block) {
+	if number := int(block.NumberU64()); number <= len(n.chain) {
+		n.chain = n.chain[:number-1]
+	}
+	n.head = block
+	n.chain = append(n.chain, block)
+}
+
+func (n *Node) Verify(interfaces.Proposal) (time.Duration, error) { return 0, nil }
+
+func (n *Node) VerifyUnsealedProposal(interfaces.Proposal) (time.Duration, error) {
+	return 0, nil
+}
+
+func (n *Node) LastProposal() (interfaces.Proposal, common.Address) {
+	head := n.Head()
+	return head, head.Coinbase()
+}
+
+func (n *Node) AddEvidence(evidence *types.HotstuffEvidence) {
+	n.lock.Lock()
+	defer n.lock.Unlock()
+	n.evidence = append(n.evidence, evidence)
+}
+
+func (n *Node) Validators(number uint64) interfaces.ValidatorSet {
+	return validator.NewSetWithPublicKeys(n.net.addrs, n.net.pubKeys, n.net.config.LeaderPolicy)
+}
+
+func (n *Node) NextValidators(proposal interfaces.Proposal) interfaces.ValidatorSet {
+	return validator.NewSetWithPublicKeys(n.net.addrs, n.net.pubKeys, n.net.config.LeaderPolicy)
+}
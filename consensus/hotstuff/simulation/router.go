@@ -0,0 +1,81 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+)
+
+// Router decides the fate of a message sent by a validator to another one: it
+// returns whether the message is delivered, and after which delay. Messages a
+// validator sends to itself are always delivered immediately. The message is nil
+// when the router is asked whether a validator can sync the chain of another.
+type Router func(from, to common.Address, msg *core.Message) (time.Duration, bool)
+
+// Delay delivers every message after a random delay in [min, max), which also
+// reorders the messages sent close to each other.
+func Delay(min, max time.Duration) Router {
+	return func(from, to common.Address, msg *core.Message) (time.Duration, bool) {
+		if max <= min {
+			return min, true
+		}
+		return min + time.Duration(rand.Int63n(int64(max-min))), true
+	}
+}
+
+// Drop loses every message with the given probability.
+func Drop(rate float64) Router {
+	return func(from, to common.Address, msg *core.Message) (time.Duration, bool) {
+		return 0, rand.Float64() >= rate
+	}
+}
+
+// Partition only delivers the messages exchanged within the same group of
+// validators, isolating the validators part of no group.
+func Partition(groups ...[]common.Address) Router {
+	group := make(map[common.Address]int)
+	for i, addrs := range groups {
+		for _, addr := range addrs {
+			group[addr] = i + 1
+		}
+	}
+	return func(from, to common.Address, msg *core.Message) (time.Duration, bool) {
+		return 0, group[from] != 0 && group[from] == group[to]
+	}
+}
+
+// Combine delivers a message only if all routers deliver it, after the sum of
+// their delays.
+func Combine(routers ...Router) Router {
+	return func(from, to common.Address, msg *core.Message) (time.Duration, bool) {
+		var total time.Duration
+		for _, router := range routers {
+			delay, deliver := router(from, to, msg)
+			if !deliver {
+				return 0, false
+			}
+			total += delay
+		}
+		return total, true
+	}
+}
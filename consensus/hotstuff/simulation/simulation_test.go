@@ -0,0 +1,179 @@
+package simulation
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/hotstuff/config"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// testConfigs returns the basic and chained protocol configurations with short
+// round timeouts, so that faulty rounds are changed quickly.
+func testConfigs() map[string]*config.Config {
+	basic := *config.DefaultBasicConfig
+	basic.RequestTimeout = 300
+
+	chained := basic
+	chained.Chained = true
+
+	return map[string]*config.Config{"basic": &basic, "chained": &chained}
+}
+
+func newTestNetwork(t *testing.T, n int, conf *config.Config) *Network {
+	net, err := NewNetwork(n, conf)
+	if err != nil {
+		t.Fatalf("failed to create network: %v", err)
+	}
+	return net
+}
+
+func TestLiveness(t *testing.T) {
+	for name, conf := range testConfigs() {
+		for _, n := range []int{1, 4, 7} {
+			net := newTestNetwork(t, n, conf)
+			net.SetRouter(Delay(0, 10*time.Millisecond))
+			if err := net.Start(); err != nil {
+				t.Fatalf("%s n=%d: failed to start network: %v", name, n, err)
+			}
+			if err := net.WaitHeight(5, 10*time.Second); err != nil {
+				t.Errorf("%s n=%d: %v", name, n, err)
+			}
+			if err := net.CheckSafety(); err != nil {
+				t.Errorf("%s n=%d: %v", name, n, err)
+			}
+			net.Stop()
+		}
+	}
+}
+
+func TestCrashFaults(t *testing.T) {
+	for name, conf := range testConfigs() {
+		net := newTestNetwork(t, 7, conf)
+		if err := net.Start(); err != nil {
+			t.Fatalf("%s: failed to start network: %v", name, err)
+		}
+		// f = 2 validators out of 7 crash, the others keep committing
+		for _, node := range net.Nodes()[:2] {
+			node.Stop()
+		}
+		if err := net.WaitHeight(5, 20*time.Second); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		// a restarted validator catches up with the others
+		net.Nodes()[0].Start()
+		if err := net.WaitHeight(net.Nodes()[2].Head().NumberU64()+2, 20*time.Second); err != nil {
+			t.Errorf("%s: restarted validator: %v", name, err)
+		}
+		if err := net.CheckSafety(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		net.Stop()
+	}
+}
+
+func TestPartition(t *testing.T) {
+	for name, conf := range testConfigs() {
+		net := newTestNetwork(t, 4, conf)
+
+		var left, right []common.Address
+		for i, node := range net.Nodes() {
+			if i < 2 {
+				left = append(left, node.Address())
+			} else {
+				right = append(right, node.Address())
+			}
+		}
+		net.SetRouter(Partition(left, right))
+		if err := net.Start(); err != nil {
+			t.Fatalf("%s: failed to start network: %v", name, err)
+		}
+		// neither half has a quorum, nothing can be committed
+		if err := net.WaitHeight(1, time.Second); err == nil {
+			t.Errorf("%s: block committed without quorum", name)
+		}
+		// once healed, the network makes progress again
+		net.SetRouter(nil)
+		if err := net.WaitHeight(3, 20*time.Second); err != nil {
+			t.Errorf("%s: healed network: %v", name, err)
+		}
+		if err := net.CheckSafety(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		net.Stop()
+	}
+}
+
+func TestUnreliableNetwork(t *testing.T) {
+	for name, conf := range testConfigs() {
+		net := newTestNetwork(t, 4, conf)
+		net.SetRouter(Combine(Drop(0.05), Delay(0, 50*time.Millisecond)))
+		if err := net.Start(); err != nil {
+			t.Fatalf("%s: failed to start network: %v", name, err)
+		}
+		if err := net.WaitHeight(5, 30*time.Second); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		if err := net.CheckSafety(); err != nil {
+			t.Errorf("%s: %v", name, err)
+		}
+		net.Stop()
+	}
+}
+
+func TestByzantineFaults(t *testing.T) {
+	behaviours := map[string]func() Byzantine{
+		"silent":     Silent,
+		"equivocate": Equivocate,
+	}
+	for name, conf := range testConfigs() {
+		for kind, byzantine := range behaviours {
+			net := newTestNetwork(t, 4, conf)
+			net.SetRouter(Delay(0, 10*time.Millisecond))
+
+			// f = 1 validator out of 4 is Byzantine, the others keep committing
+			net.Nodes()[0].SetByzantine(byzantine())
+			if err := net.Start(); err != nil {
+				t.Fatalf("%s %s: failed to start network: %v", name, kind, err)
+			}
+			if err := net.WaitHeight(6, 30*time.Second); err != nil {
+				t.Errorf("%s %s: %v", name, kind, err)
+			}
+			if err := net.CheckSafety(); err != nil {
+				t.Errorf("%s %s: %v", name, kind, err)
+			}
+			net.Stop()
+		}
+	}
+}
+
+func TestCheckSafety(t *testing.T) {
+	net := newTestNetwork(t, 2, config.DefaultBasicConfig)
+	first, second := net.Nodes()[0], net.Nodes()[1]
+
+	child := func(parent *types.Block, coinbase common.Address) *types.Block {
+		return types.NewBlockWithHeader(&types.Header{
+			Number:     new(big.Int).Add(parent.Number(), common.Big1),
+			ParentHash: parent.Hash(),
+			Coinbase:   coinbase,
+		})
+	}
+	block := child(net.Genesis(), first.Address())
+	first.committed = []*types.Block{block}
+	second.committed = []*types.Block{block, child(block, second.Address())}
+	if err := net.CheckSafety(); err != nil {
+		t.Fatalf("consistent commits reported unsafe: %v", err)
+	}
+	// conflicting blocks at the same height are detected
+	second.committed = []*types.Block{child(net.Genesis(), second.Address())}
+	if err := net.CheckSafety(); err == nil {
+		t.Fatalf("conflicting commits not detected")
+	}
+	// so are gaps in a committed chain
+	second.committed = []*types.Block{child(block, second.Address())}
+	if err := net.CheckSafety(); err == nil {
+		t.Fatalf("non contiguous commits not detected")
+	}
+}
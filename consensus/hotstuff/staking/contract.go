@@ -0,0 +1,54 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// ABI is the interface of the staking contract.
+const ABI = `[
+	{"type":"function","name":"register","stateMutability":"nonpayable","inputs":[{"name":"pubkey","type":"bytes"},{"name":"pop","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"stake","stateMutability":"payable","inputs":[],"outputs":[]},
+	{"type":"function","name":"unbond","stateMutability":"nonpayable","inputs":[{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"withdraw","stateMutability":"nonpayable","inputs":[],"outputs":[]},
+	{"type":"function","name":"validators","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address[]"}]},
+	{"type":"function","name":"validator","stateMutability":"view","inputs":[{"name":"addr","type":"address"}],"outputs":[{"name":"stake","type":"uint256"},{"name":"pubkey","type":"bytes"},{"name":"pop","type":"bytes"}]}
+]`
+
+// Code is the runtime bytecode of the staking contract, compiled from contract.evm.
+var Code = common.FromHex("60003560e01c8063a3747fef1463000000595780633a4b66f11463000000f057806327de9e321463000001125780633ccfd60b146300000151578063ca1e781914630000019c578063223b3b7a1463000001e8575b600080fd5b3463000000545760043560040180356030141563000000545760243560040180356060141563000000545733600052600260205260406000208260200135816002015582604001358160030155816020013581600401558160400135816005015581606001358160060155806001015463000000ee576001546001600052602060002081013390556001018082600101556001555b005b3360005260026020526040600020806001015415630000005457805434019055005b34630000005457336000526002602052604060002060043581548181106300000054578190038255816007015401816007015543600054019060080155005b346300000054573360005260026020526040600020806008015443106300000054578060070154801563000000545760008260070155600060006000600084335af115630000005457005b346300000054576020600052600154806020526001604052602060402060005b8281101563000001dc5780820154816020026040015260010163000001bc565b50506020026040016000f35b34630000005457600435600052600260205260406000208054600052606060205260c060405260306060528060020154608052806003015460a052606060c052806004015460e0528060050154610100528060060154610120526101406000f3")
+
+// unbondingSlot is the storage slot holding the unbonding period of the contract.
+var unbondingSlot = common.Hash{}
+
+// GenesisAccount returns the genesis account deploying the staking contract,
+// whose unbonded stake is withdrawable after the given number of blocks.
+func GenesisAccount(unbonding uint64) core.GenesisAccount {
+	return core.GenesisAccount{
+		Code: common.CopyBytes(Code),
+		Storage: map[common.Hash]common.Hash{
+			unbondingSlot: common.BigToHash(new(big.Int).SetUint64(unbonding)),
+		},
+		Balance: new(big.Int),
+	}
+}
@@ -0,0 +1,154 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+// Package staking implements the system contract electing the validators of a
+// hotstuff chain by their stake, and the reading of its candidates.
+package staking
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"sort"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+)
+
+var (
+	parsedABI, _ = abi.JSON(strings.NewReader(ABI))
+
+	// errInvalidValidators is returned if the contract returns malformed validators.
+	errInvalidValidators = errors.New("invalid staking validators")
+)
+
+// Candidate is an account registered in the staking contract.
+type Candidate struct {
+	Address   common.Address
+	Stake     *big.Int
+	PublicKey []byte // BLS consensus key of the candidate
+	Proof     []byte // Proof of possession of the consensus key
+}
+
+// Caller executes a read only call of the staking contract with the given input.
+type Caller func(input []byte) ([]byte, error)
+
+// Candidates retrieves the registered candidates, along their stake and key.
+func Candidates(call Caller) ([]*Candidate, error) {
+	input, err := parsedABI.Pack("validators")
+	if err != nil {
+		return nil, err
+	}
+	output, err := call(input)
+	if err != nil {
+		return nil, err
+	}
+	var addrs []common.Address
+	if err := parsedABI.UnpackIntoInterface(&addrs, "validators", output); err != nil {
+		return nil, err
+	}
+	candidates := make([]*Candidate, 0, len(addrs))
+	for _, addr := range addrs {
+		input, err := parsedABI.Pack("validator", addr)
+		if err != nil {
+			return nil, err
+		}
+		output, err := call(input)
+		if err != nil {
+			return nil, err
+		}
+		values, err := parsedABI.Unpack("validator", output)
+		if err != nil {
+			return nil, err
+		}
+		stake, ok1 := values[0].(*big.Int)
+		pubKey, ok2 := values[1].([]byte)
+		proof, ok3 := values[2].([]byte)
+		if !ok1 || !ok2 || !ok3 {
+			return nil, errInvalidValidators
+		}
+		candidates = append(candidates, &Candidate{
+			Address:   addr,
+			Stake:     stake,
+			PublicKey: pubKey,
+			Proof:     proof,
+		})
+	}
+	return candidates, nil
+}
+
+// Elect picks the validators of the next epoch out of the candidates: the ones
+// with the most stake, at least the minimum one, which proved possession of
+// their consensus key, up to the maximum number if not zero. The validators
+// are returned in ascending address order.
+func Elect(candidates []*Candidate, minStake *big.Int, max uint64) []*Candidate {
+	elected := make([]*Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if c.Stake == nil || c.Stake.Sign() == 0 || (minStake != nil && c.Stake.Cmp(minStake) < 0) {
+			continue
+		}
+		if !VerifyProofOfPossession(c.Address, c.PublicKey, c.Proof) {
+			continue
+		}
+		elected = append(elected, c)
+	}
+	if max != 0 && uint64(len(elected)) > max {
+		// Ties on the stake are broken by the address, for every node to agree
+		sort.Slice(elected, func(i, j int) bool {
+			if cmp := elected[i].Stake.Cmp(elected[j].Stake); cmp != 0 {
+				return cmp > 0
+			}
+			return bytes.Compare(elected[i].Address[:], elected[j].Address[:]) < 0
+		})
+		elected = elected[:max]
+	}
+	sort.Slice(elected, func(i, j int) bool {
+		return bytes.Compare(elected[i].Address[:], elected[j].Address[:]) < 0
+	})
+	return elected
+}
+
+// proofHash is the message signed by the consensus key of a candidate to prove
+// its possession, which binds the key to the account registering it.
+func proofHash(addr common.Address) []byte {
+	return crypto.Keccak256(addr.Bytes())
+}
+
+// ProofOfPossession signs the proof that the account owns the consensus key.
+func ProofOfPossession(key blscommon.SecretKey, addr common.Address) []byte {
+	return key.Sign(proofHash(addr)).Marshal()
+}
+
+// VerifyProofOfPossession reports whether the proof shows the account owns the
+// consensus key. The EVM has no BLS precompile, so the proofs registered in the
+// contract are only checked when electing the validators.
+func VerifyProofOfPossession(addr common.Address, pubKey, proof []byte) bool {
+	key, err := blst.PublicKeyFromBytes(pubKey)
+	if err != nil {
+		return false
+	}
+	sig, err := blst.SignatureFromBytes(proof)
+	if err != nil {
+		return false
+	}
+	return sig.Verify(key, proofHash(addr))
+}
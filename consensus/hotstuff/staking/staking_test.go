@@ -0,0 +1,184 @@
+/*
+ * Copyright (C) 2022 The Unicorn Authors
+ * This file is part of The Unicorn library.
+ *
+ * The Unicorn is free software: you can redistribute it and/or modify
+ * it under the terms of the GNU Lesser General Public License as published by
+ * the Free Software Foundation, either version 3 of the License, or
+ * (at your option) any later version.
+ *
+ * The Unicorn is distributed in the hope that it will be useful,
+ * but WITHOUT ANY WARRANTY; without even the implied warranty of
+ * MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE.  See the
+ * GNU Lesser General Public License for more details.
+ *
+ * You should have received a copy of the GNU Lesser General Public License
+ * along with The Unicorn.  If not, see <http://www.gnu.org/licenses/>.
+ */
+
+package staking
+
+import (
+	"math/big"
+	"os"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	"github.com/stretchr/testify/assert"
+)
+
+// Tests that the bytecode is the one of the contract source.
+func TestCode(t *testing.T) {
+	src, err := os.ReadFile("contract.evm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiler := asm.NewCompiler(false)
+	compiler.Feed(asm.Lex(src, false))
+	bin, errs := compiler.Compile()
+	if len(errs) > 0 {
+		t.Fatalf("failed to compile contract: %v", errs)
+	}
+	assert.Equal(t, common.FromHex(bin), Code)
+}
+
+// testContract is the staking contract deployed in a memory state.
+type testContract struct {
+	t       *testing.T
+	state   *state.StateDB
+	address common.Address
+	number  uint64
+}
+
+func newTestContract(t *testing.T, unbonding uint64) *testContract {
+	statedb, _ := state.New(common.Hash{}, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	address := common.HexToAddress("0x1000")
+	account := GenesisAccount(unbonding)
+	statedb.SetCode(address, account.Code)
+	for key, value := range account.Storage {
+		statedb.SetState(address, key, value)
+	}
+	return &testContract{t: t, state: statedb, address: address, number: 1}
+}
+
+// call executes a call of the contract from the account, reporting whether it
+// succeeded along its output.
+func (c *testContract) call(from common.Address, value *big.Int, method string, args ...interface{}) ([]byte, bool) {
+	input, err := parsedABI.Pack(method, args...)
+	if err != nil {
+		c.t.Fatal(err)
+	}
+	return c.callInput(from, value, input)
+}
+
+func (c *testContract) callInput(from common.Address, value *big.Int, input []byte) ([]byte, bool) {
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: new(big.Int).SetUint64(c.number),
+		Difficulty:  new(big.Int),
+	}
+	evm := vm.NewEVM(blockCtx, vm.TxContext{}, c.state, params.AllEthashProtocolChanges, vm.Config{})
+	c.state.PrepareAccessList(from, &c.address, vm.ActivePrecompiles(evm.ChainConfig().Rules(blockCtx.BlockNumber)), nil)
+	output, _, err := evm.Call(vm.AccountRef(from), c.address, input, 1000000, value)
+	return output, err == nil
+}
+
+func (c *testContract) reader() Caller {
+	return func(input []byte) ([]byte, error) {
+		output, ok := c.callInput(common.Address{}, new(big.Int), input)
+		if !ok {
+			return nil, errInvalidValidators
+		}
+		return output, nil
+	}
+}
+
+func TestContract(t *testing.T) {
+	c := newTestContract(t, 10)
+
+	key, _ := blst.RandKey()
+	addr := common.HexToAddress("0x01")
+	c.state.AddBalance(addr, big.NewInt(1000))
+	pubKey, proof := key.PublicKey().Marshal(), ProofOfPossession(key, addr)
+
+	// Staking requires registering a consensus key first
+	_, ok := c.call(addr, big.NewInt(100), "stake")
+	assert.False(t, ok)
+	_, ok = c.call(addr, new(big.Int), "register", pubKey[:47], proof)
+	assert.False(t, ok)
+	_, ok = c.call(addr, new(big.Int), "register", pubKey, proof)
+	assert.True(t, ok)
+
+	_, ok = c.call(addr, big.NewInt(100), "stake")
+	assert.True(t, ok)
+	_, ok = c.call(addr, big.NewInt(200), "stake")
+	assert.True(t, ok)
+
+	// Registering again replaces the key without listing the candidate twice
+	_, ok = c.call(addr, new(big.Int), "register", pubKey, proof)
+	assert.True(t, ok)
+
+	candidates, err := Candidates(c.reader())
+	assert.NoError(t, err)
+	assert.Len(t, candidates, 1)
+	assert.Equal(t, addr, candidates[0].Address)
+	assert.Equal(t, big.NewInt(300), candidates[0].Stake)
+	assert.Equal(t, pubKey, candidates[0].PublicKey)
+	assert.Equal(t, proof, candidates[0].Proof)
+	assert.Equal(t, big.NewInt(700), c.state.GetBalance(addr))
+
+	// Unbonded stake is withdrawable once the unbonding period elapsed
+	_, ok = c.call(addr, new(big.Int), "unbond", big.NewInt(301))
+	assert.False(t, ok)
+	_, ok = c.call(addr, new(big.Int), "unbond", big.NewInt(250))
+	assert.True(t, ok)
+	_, ok = c.call(addr, new(big.Int), "withdraw")
+	assert.False(t, ok)
+
+	c.number += 10
+	_, ok = c.call(addr, new(big.Int), "withdraw")
+	assert.True(t, ok)
+	_, ok = c.call(addr, new(big.Int), "withdraw")
+	assert.False(t, ok)
+	assert.Equal(t, big.NewInt(950), c.state.GetBalance(addr))
+
+	candidates, err = Candidates(c.reader())
+	assert.NoError(t, err)
+	assert.Equal(t, big.NewInt(50), candidates[0].Stake)
+}
+
+func TestElect(t *testing.T) {
+	var candidates []*Candidate
+	for i := 0; i < 5; i++ {
+		key, _ := blst.RandKey()
+		addr := common.BytesToAddress([]byte{byte(5 - i)})
+		candidates = append(candidates, &Candidate{
+			Address:   addr,
+			Stake:     big.NewInt(int64(100 * (i + 1))),
+			PublicKey: key.PublicKey().Marshal(),
+			Proof:     ProofOfPossession(key, addr),
+		})
+	}
+	// A key proven for another account is not elected
+	candidates[4].Proof = candidates[3].Proof
+
+	elected := Elect(candidates, big.NewInt(200), 2)
+	assert.Len(t, elected, 2)
+	assert.Equal(t, candidates[3], elected[0])
+	assert.Equal(t, candidates[2], elected[1])
+
+	elected = Elect(candidates, nil, 0)
+	assert.Len(t, elected, 4)
+	for i := 1; i < len(elected); i++ {
+		assert.Equal(t, -1, elected[i-1].Address.Hash().Big().Cmp(elected[i].Address.Hash().Big()))
+	}
+	assert.False(t, VerifyProofOfPossession(common.Address{}, candidates[0].PublicKey, candidates[0].Proof))
+}
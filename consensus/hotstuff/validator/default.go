@@ -19,26 +19,34 @@
 package validator
 
 import (
+	"encoding/binary"
 	"errors"
 	. "github.com/ethereum/go-ethereum/consensus/hotstuff/interfaces"
 	"math"
+	"math/big"
 	"reflect"
 	"sort"
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
 var ErrInvalidParticipant = errors.New("invalid participants")
 
 type defaultValidator struct {
-	address common.Address
+	address   common.Address
+	publicKey []byte
 }
 
 func (val *defaultValidator) Address() common.Address {
 	return val.address
 }
 
+func (val *defaultValidator) PublicKey() []byte {
+	return val.publicKey
+}
+
 func (val *defaultValidator) String() string {
 	return val.Address().String()
 }
@@ -52,6 +60,7 @@ type defaultSet struct {
 	proposer    Validator
 	validatorMu sync.RWMutex
 	selector    ProposalSelector
+	seed        common.Hash
 }
 
 func newDefaultSet(addrs []common.Address, policy SelectProposerPolicy) *defaultSet {
@@ -178,10 +187,14 @@ func (valSet *defaultSet) Copy() ValidatorSet {
 	defer valSet.validatorMu.RUnlock()
 
 	addresses := make([]common.Address, 0, len(valSet.validators))
+	pubKeys := make(map[common.Address][]byte, len(valSet.validators))
 	for _, v := range valSet.validators {
 		addresses = append(addresses, v.Address())
+		pubKeys[v.Address()] = v.PublicKey()
 	}
-	return NewSet(addresses, valSet.policy)
+	cpy := NewSetWithPublicKeys(addresses, pubKeys, valSet.policy)
+	cpy.SetSeed(valSet.seed)
+	return cpy
 }
 
 func (valSet *defaultSet) ParticipantsNumber(list []common.Address) int {
@@ -223,6 +236,10 @@ func (valSet *defaultSet) Q() int { return int(math.Ceil(float64(2*valSet.Size()
 
 func (valSet *defaultSet) Policy() SelectProposerPolicy { return valSet.policy }
 
+func (valSet *defaultSet) Seed() common.Hash { return valSet.seed }
+
+func (valSet *defaultSet) SetSeed(seed common.Hash) { valSet.seed = seed }
+
 func (valSet *defaultSet) Cmp(src ValidatorSet) bool {
 	n := valSet.ParticipantsNumber(src.AddressList())
 	if n != valSet.Size() || n != src.Size() {
@@ -271,7 +288,17 @@ func stickySelector(valSet ValidatorSet, proposer common.Address, round uint64)
 	return valSet.GetByIndex(pick)
 }
 
-// TODO: implement VRF
+// vrfSelector elects the proposer of the round out of the seed, which is the VRF
+// output of the parent block's proposer. Unlike with the other policies, nobody
+// can tell the proposers of a height before its parent block is proposed.
 func vrfSelector(valSet ValidatorSet, proposer common.Address, round uint64) Validator {
-	return nil
+	if valSet.Size() == 0 {
+		return nil
+	}
+	seed := valSet.Seed()
+	var enc [8]byte
+	binary.BigEndian.PutUint64(enc[:], round)
+	hash := new(big.Int).SetBytes(crypto.Keccak256(seed[:], enc[:]))
+	pick := hash.Mod(hash, big.NewInt(int64(valSet.Size())))
+	return valSet.GetByIndex(pick.Uint64())
 }
@@ -248,3 +248,35 @@ func TestFAndQ(t *testing.T) {
 	assert.Equal(t, 5, quorumSize)
 	t.Logf("faulty size %d, quorum size %d", faultySize, quorumSize)
 }
+
+func TestVRFProposer(t *testing.T) {
+	addrs := make([]common.Address, 0, 16)
+	for i := 0; i < 16; i++ {
+		addrs = append(addrs, common.BytesToAddress([]byte{byte(i + 1)}))
+	}
+	valSet := NewSet(addrs, interfaces.VRF)
+
+	// The election only depends on the seed and the round
+	elect := func(seed common.Hash, round uint64) common.Address {
+		valSet.SetSeed(seed)
+		valSet.CalcProposer(common.Address{}, round)
+		return valSet.GetProposer().Address()
+	}
+	seed := crypto.Keccak256Hash([]byte("seed"))
+	proposer := elect(seed, 0)
+	assert.Equal(t, proposer, elect(seed, 0))
+	assert.Equal(t, seed, valSet.Copy().Seed())
+
+	// Changing the seed or the round reshuffles the proposers
+	elected := make(map[common.Address]bool)
+	for i := 0; i < 64; i++ {
+		elected[elect(crypto.Keccak256Hash(seed[:], []byte{byte(i)}), 0)] = true
+		elected[elect(seed, uint64(i))] = true
+	}
+	assert.Greater(t, len(elected), 8, "proposers are not spread over the validators")
+
+	// Empty sets elect nobody
+	empty := NewSet(nil, interfaces.VRF)
+	empty.CalcProposer(common.Address{}, 0)
+	assert.Nil(t, empty.GetProposer())
+}
@@ -29,10 +29,32 @@ func New(addr common.Address) Validator {
 	}
 }
 
+// NewWithPublicKey creates a validator whose seals are verified with the given
+// BLS consensus key.
+func NewWithPublicKey(addr common.Address, pubKey []byte) Validator {
+	return &defaultValidator{
+		address:   addr,
+		publicKey: common.CopyBytes(pubKey),
+	}
+}
+
 func NewSet(addrs []common.Address, policy SelectProposerPolicy) ValidatorSet {
 	return newDefaultSet(addrs, policy)
 }
 
+// NewSetWithPublicKeys creates a validator set whose validators carry the BLS
+// consensus keys of the given map, as recorded by the validator snapshot.
+func NewSetWithPublicKeys(addrs []common.Address, pubKeys map[common.Address][]byte, policy SelectProposerPolicy) ValidatorSet {
+	valSet := newDefaultSet(addrs, policy)
+	for i, val := range valSet.validators {
+		valSet.validators[i] = NewWithPublicKey(val.Address(), pubKeys[val.Address()])
+	}
+	if valSet.Size() > 0 {
+		valSet.proposer = valSet.GetByIndex(0)
+	}
+	return valSet
+}
+
 func ExtractValidators(extraData []byte) []common.Address {
 	// get the validator addresses
 	addrs := make([]common.Address, (len(extraData) / common.AddressLength))
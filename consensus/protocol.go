@@ -0,0 +1,47 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package consensus
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Broadcaster defines the interface to find the peers a consensus engine
+// exchanges messages with.
+type Broadcaster interface {
+	// FindPeers retrieves the connected peers by their node addresses.
+	FindPeers(targets map[common.Address]bool) map[common.Address]Peer
+
+	// Peers retrieves all the connected peers by their node addresses.
+	Peers() map[common.Address]Peer
+}
+
+// Peer defines the interface to communicate with a peer.
+type Peer interface {
+	// Send sends the message to this peer.
+	Send(msgcode uint64, data interface{}) error
+}
+
+// Handler should be implemented by the consensus engine if it needs to exchange
+// customized messages with the remote peers.
+type Handler interface {
+	// HandleMsg handles a consensus message relayed by the peer with the given address.
+	HandleMsg(address common.Address, payload []byte) error
+
+	// SetBroadcaster sets the broadcaster used to send messages to peers.
+	SetBroadcaster(Broadcaster)
+}
@@ -51,6 +51,7 @@ var (
 	headBlockGauge     = metrics.NewRegisteredGauge("chain/head/block", nil)
 	headHeaderGauge    = metrics.NewRegisteredGauge("chain/head/header", nil)
 	headFastBlockGauge = metrics.NewRegisteredGauge("chain/head/receipt", nil)
+	headFinalizedGauge = metrics.NewRegisteredGauge("chain/head/finalized", nil)
 
 	accountReadTimer   = metrics.NewRegisteredTimer("chain/account/reads", nil)
 	accountHashTimer   = metrics.NewRegisteredTimer("chain/account/hashes", nil)
@@ -188,8 +189,9 @@ type BlockChain struct {
 	// Readers don't need to take it, they can just read the database.
 	chainmu *syncx.ClosableMutex
 
-	currentBlock     atomic.Value // Current head of the block chain
-	currentFastBlock atomic.Value // Current head of the fast-sync chain (may be above the block chain!)
+	currentBlock          atomic.Value // Current head of the block chain
+	currentFastBlock      atomic.Value // Current head of the fast-sync chain (may be above the block chain!)
+	currentFinalizedBlock atomic.Value // Latest block finalized by the consensus engine (nil without instant finality)
 
 	stateCache    state.Database // State database to reuse between imports (contains state cache)
 	bodyCache     *lru.Cache     // Cache for the most recent block bodies
@@ -204,11 +206,12 @@ type BlockChain struct {
 	running       int32          // 0 if chain is running, 1 when stopped
 	procInterrupt int32          // interrupt signaler for block processing
 
-	engine     consensus.Engine
-	validator  Validator // Block and state validator interface
-	prefetcher Prefetcher
-	processor  Processor // Block transaction processor interface
-	vmConfig   vm.Config
+	engine          consensus.Engine
+	instantFinality bool      // Whether every block accepted by the engine carries a quorum certificate
+	validator       Validator // Block and state validator interface
+	prefetcher      Prefetcher
+	processor       Processor // Block transaction processor interface
+	vmConfig        vm.Config
 
 	shouldPreserve func(*types.Block) bool // Function used to determine whether should preserve the given block.
 }
@@ -249,6 +252,10 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		engine:         engine,
 		vmConfig:       vmConfig,
 	}
+	// Chained hotstuff engines finalize the blocks once their three-chain forms
+	if _, ok := engine.(consensus.Hotstuff); ok {
+		bc.instantFinality = chainConfig.HotStuff == nil || !chainConfig.HotStuff.Chained
+	}
 	bc.validator = NewBlockValidator(chainConfig, bc, engine)
 	bc.prefetcher = newStatePrefetcher(chainConfig, bc, engine)
 	bc.processor = NewStateProcessor(chainConfig, bc, engine)
@@ -266,6 +273,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 	var nilBlock *types.Block
 	bc.currentBlock.Store(nilBlock)
 	bc.currentFastBlock.Store(nilBlock)
+	bc.currentFinalizedBlock.Store(nilBlock)
 
 	// Initialize the chain with ancient data if it isn't empty.
 	var txIndexBlock uint64
@@ -306,7 +314,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 			}
 		} else {
 			log.Warn("Head state missing, repairing", "number", head.Number(), "hash", head.Hash())
-			if err := bc.SetHead(head.NumberU64()); err != nil {
+			if err := bc.setHead(head.NumberU64()); err != nil {
 				return nil, err
 			}
 		}
@@ -338,7 +346,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 		}
 		if needRewind {
 			log.Error("Truncating ancient chain", "from", bc.CurrentHeader().Number.Uint64(), "to", low)
-			if err := bc.SetHead(low); err != nil {
+			if err := bc.setHead(low); err != nil {
 				return nil, err
 			}
 		}
@@ -356,7 +364,7 @@ func NewBlockChain(db ethdb.Database, cacheConfig *CacheConfig, chainConfig *par
 			// make sure the headerByNumber (if present) is in our current canonical chain
 			if headerByNumber != nil && headerByNumber.Hash() == header.Hash() {
 				log.Error("Found bad hash, rewinding chain", "number", header.Number, "hash", header.ParentHash)
-				if err := bc.SetHead(header.Number.Uint64() - 1); err != nil {
+				if err := bc.setHead(header.Number.Uint64() - 1); err != nil {
 					return nil, err
 				}
 				log.Error("Chain rewind was successful, resuming normal operation")
@@ -462,6 +470,17 @@ func (bc *BlockChain) loadLastState() error {
 			headFastBlockGauge.Update(int64(block.NumberU64()))
 		}
 	}
+	// Restore the last known finalized block. Chains without one fall back to the
+	// genesis, as the head may have been reached without verifying its QC.
+	if head := rawdb.ReadFinalizedBlockHash(bc.db); head != (common.Hash{}) {
+		if block := bc.GetBlockByHash(head); block != nil {
+			bc.currentFinalizedBlock.Store(block)
+			headFinalizedGauge.Update(int64(block.NumberU64()))
+		}
+	} else if bc.instantFinality {
+		bc.currentFinalizedBlock.Store(bc.genesisBlock)
+		headFinalizedGauge.Update(0)
+	}
 	// Issue a status log for the user
 	currentFastBlock := bc.CurrentFastBlock()
 
@@ -475,13 +494,28 @@ func (bc *BlockChain) loadLastState() error {
 	if pivot := rawdb.ReadLastPivotNumber(bc.db); pivot != nil {
 		log.Info("Loaded last fast-sync pivot marker", "number", *pivot)
 	}
+	if finalized := bc.CurrentFinalizedBlock(); finalized != nil {
+		log.Info("Loaded most recent finalized block", "number", finalized.Number(), "hash", finalized.Hash(), "age", common.PrettyAge(time.Unix(int64(finalized.Time()), 0)))
+	}
 	return nil
 }
 
 // SetHead rewinds the local chain to a new head. Depending on whether the node
 // was fast synced or full synced and in which state, the method will try to
 // delete minimal data from disk whilst retaining chain consistency.
+//
+// Finalized blocks are never rewound: a head below the finalized block is
+// rejected with ErrFinalizedRewind.
 func (bc *BlockChain) SetHead(head uint64) error {
+	if finalized := bc.CurrentFinalizedBlock(); finalized != nil && head < finalized.NumberU64() {
+		return fmt.Errorf("%w: head %d, finalized %d", ErrFinalizedRewind, head, finalized.NumberU64())
+	}
+	return bc.setHead(head)
+}
+
+// setHead rewinds the local chain to a new head regardless of finality. It is
+// only meant to repair the database.
+func (bc *BlockChain) setHead(head uint64) error {
 	_, err := bc.SetHeadBeyondRoot(head, common.Hash{})
 	return err
 }
@@ -578,6 +612,15 @@ func (bc *BlockChain) SetHeadBeyondRoot(head uint64, root common.Hash) (uint64,
 		}
 		head := bc.CurrentBlock().NumberU64()
 
+		// A repair may rewind below the finalized block, degrade it to the new head
+		if finalized := bc.CurrentFinalizedBlock(); finalized != nil && head < finalized.NumberU64() {
+			newFinalized := bc.CurrentBlock()
+			rawdb.WriteFinalizedBlockHash(db, newFinalized.Hash())
+
+			bc.currentFinalizedBlock.Store(newFinalized)
+			headFinalizedGauge.Update(int64(head))
+		}
+
 		// If setHead underflown the freezer threshold and the block processing
 		// intent afterwards is full block importing, delete the chain segment
 		// between the stateful-block and the sethead target.
@@ -669,7 +712,7 @@ func (bc *BlockChain) Reset() error {
 // specified genesis state.
 func (bc *BlockChain) ResetWithGenesisBlock(genesis *types.Block) error {
 	// Dump the entire block chain and purge the caches
-	if err := bc.SetHead(0); err != nil {
+	if err := bc.setHead(0); err != nil {
 		return err
 	}
 	if !bc.chainmu.TryLock() {
@@ -686,6 +729,13 @@ func (bc *BlockChain) ResetWithGenesisBlock(genesis *types.Block) error {
 	}
 	bc.writeHeadBlock(genesis)
 
+	// The purge degraded the finalized block to the old genesis, if any
+	if bc.CurrentFinalizedBlock() != nil {
+		rawdb.WriteFinalizedBlockHash(bc.db, genesis.Hash())
+		bc.currentFinalizedBlock.Store(genesis)
+		headFinalizedGauge.Update(0)
+	}
+
 	// Last update all in-memory chain markers
 	bc.genesisBlock = genesis
 	bc.currentBlock.Store(bc.genesisBlock)
@@ -766,6 +816,34 @@ func (bc *BlockChain) writeHeadBlock(block *types.Block) {
 	headBlockGauge.Update(int64(block.NumberU64()))
 }
 
+// SetFinalized marks the given canonical block, and thereby all its ancestors,
+// as finalized. It is used by the engines committing the blocks some time after
+// they are written, such as chained hotstuff. Blocks at or below the current
+// finalized block are ignored.
+func (bc *BlockChain) SetFinalized(block *types.Block) error {
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	if bc.GetCanonicalHash(block.NumberU64()) != block.Hash() {
+		return fmt.Errorf("finalized block #%d [%x…] is not canonical", block.NumberU64(), block.Hash().Bytes()[:4])
+	}
+	bc.setFinalized(block)
+	return nil
+}
+
+// setFinalized marks the given canonical block as finalized unless a later one
+// already is, but it expects the chain mutex to be held.
+func (bc *BlockChain) setFinalized(block *types.Block) {
+	if current := bc.CurrentFinalizedBlock(); current != nil && current.NumberU64() >= block.NumberU64() {
+		return
+	}
+	rawdb.WriteFinalizedBlockHash(bc.db, block.Hash())
+	bc.currentFinalizedBlock.Store(block)
+	headFinalizedGauge.Update(int64(block.NumberU64()))
+}
+
 // Stop stops the blockchain service. If any imports are currently in progress
 // it will abort them using the procInterrupt.
 func (bc *BlockChain) Stop() {
@@ -1182,12 +1260,19 @@ func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 }
 
 // WriteBlockWithState writes the block and all associated state to the database.
+// The block comes from the local engine, so with instant finality its QC was
+// verified and it is finalized once canonical.
 func (bc *BlockChain) WriteBlockWithState(block *types.Block, receipts []*types.Receipt, logs []*types.Log, state *state.StateDB, emitHeadEvent bool) (status WriteStatus, err error) {
 	if !bc.chainmu.TryLock() {
 		return NonStatTy, errInsertionInterrupted
 	}
 	defer bc.chainmu.Unlock()
-	return bc.writeBlockWithState(block, receipts, logs, state, emitHeadEvent)
+
+	status, err = bc.writeBlockWithState(block, receipts, logs, state, emitHeadEvent)
+	if err == nil && status == CanonStatTy && bc.instantFinality {
+		bc.setFinalized(block)
+	}
+	return status, err
 }
 
 // writeBlockWithState writes the block and all associated state to the database,
@@ -1196,19 +1281,80 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	if bc.insertStopped() {
 		return NonStatTy, errInsertionInterrupted
 	}
+	// Make sure no inconsistent state is leaked during insertion
+	currentBlock := bc.CurrentBlock()
+	localTd := bc.GetTd(currentBlock.Hash(), currentBlock.NumberU64())
+
+	// Irrelevant of the canonical status, write the block itself to the database.
+	externTd, err := bc.writeBlockData(block, receipts, state)
+	if err != nil {
+		return NonStatTy, err
+	}
+	// If the total difficulty is higher than our known, add it to the canonical chain
+	// Second clause in the if statement reduces the vulnerability to selfish mining.
+	// Please refer to http://www.cs.cornell.edu/~ie53/publications/btcProcFC.pdf
+	reorg := externTd.Cmp(localTd) > 0
+	currentBlock = bc.CurrentBlock()
+	if !reorg && externTd.Cmp(localTd) == 0 {
+		// Split same-difficulty blocks by number, then preferentially select
+		// the block generated by the local miner as the canonical block.
+		if block.NumberU64() < currentBlock.NumberU64() {
+			reorg = true
+		} else if block.NumberU64() == currentBlock.NumberU64() {
+			var currentPreserve, blockPreserve bool
+			if bc.shouldPreserve != nil {
+				currentPreserve, blockPreserve = bc.shouldPreserve(currentBlock), bc.shouldPreserve(block)
+			}
+			reorg = !currentPreserve && (blockPreserve || mrand.Float64() < 0.5)
+		}
+	}
+	if reorg {
+		// Reorganise the chain if the parent is not the head block
+		if block.ParentHash() != currentBlock.Hash() {
+			if err := bc.reorg(currentBlock, block); err != nil {
+				return NonStatTy, err
+			}
+		}
+		status = CanonStatTy
+	} else {
+		status = SideStatTy
+	}
+	// Set new head.
+	if status == CanonStatTy {
+		bc.writeHeadBlock(block)
+	}
+	bc.futureBlocks.Remove(block.Hash())
+
+	if status == CanonStatTy {
+		bc.chainFeed.Send(ChainEvent{Block: block, Hash: block.Hash(), Logs: logs})
+		if len(logs) > 0 {
+			bc.logsFeed.Send(logs)
+		}
+		// In theory we should fire a ChainHeadEvent when we inject
+		// a canonical block, but sometimes we can insert a batch of
+		// canonicial blocks. Avoid firing too much ChainHeadEvents,
+		// we will fire an accumulated ChainHeadEvent and disable fire
+		// event here.
+		if emitHeadEvent {
+			bc.chainHeadFeed.Send(ChainHeadEvent{Block: block})
+		}
+	} else {
+		bc.chainSideFeed.Send(ChainSideEvent{Block: block})
+	}
+	return status, nil
+}
 
+// writeBlockData writes the block, its receipts and its state to the database,
+// whatever its canonical status, and returns the total difficulty of the block.
+// It expects the chain mutex to be held.
+func (bc *BlockChain) writeBlockData(block *types.Block, receipts []*types.Receipt, state *state.StateDB) (*big.Int, error) {
 	// Calculate the total difficulty of the block
 	ptd := bc.GetTd(block.ParentHash(), block.NumberU64()-1)
 	if ptd == nil {
-		return NonStatTy, consensus.ErrUnknownAncestor
+		return nil, consensus.ErrUnknownAncestor
 	}
-	// Make sure no inconsistent state is leaked during insertion
-	currentBlock := bc.CurrentBlock()
-	localTd := bc.GetTd(currentBlock.Hash(), currentBlock.NumberU64())
 	externTd := new(big.Int).Add(block.Difficulty(), ptd)
 
-	// Irrelevant of the canonical status, write the block itself to the database.
-	//
 	// Note all the components of block(td, hash->number map, header, body, receipts)
 	// should be written atomically. BlockBatch is used for containing all components.
 	blockBatch := bc.db.NewBatch()
@@ -1222,14 +1368,14 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 	// Commit all cached state changes into underlying memory database.
 	root, err := state.Commit(bc.chainConfig.IsEIP158(block.Number()))
 	if err != nil {
-		return NonStatTy, err
+		return nil, err
 	}
 	triedb := bc.stateCache.TrieDB()
 
 	// If we're running an archive node, always flush
 	if bc.cacheConfig.TrieDirtyDisabled {
 		if err := triedb.Commit(root, false, nil); err != nil {
-			return NonStatTy, err
+			return nil, err
 		}
 	} else {
 		// Full but not archive node, do proper garbage collection
@@ -1278,58 +1424,75 @@ func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.
 			}
 		}
 	}
-	// If the total difficulty is higher than our known, add it to the canonical chain
-	// Second clause in the if statement reduces the vulnerability to selfish mining.
-	// Please refer to http://www.cs.cornell.edu/~ie53/publications/btcProcFC.pdf
-	reorg := externTd.Cmp(localTd) > 0
-	currentBlock = bc.CurrentBlock()
-	if !reorg && externTd.Cmp(localTd) == 0 {
-		// Split same-difficulty blocks by number, then preferentially select
-		// the block generated by the local miner as the canonical block.
-		if block.NumberU64() < currentBlock.NumberU64() {
-			reorg = true
-		} else if block.NumberU64() == currentBlock.NumberU64() {
-			var currentPreserve, blockPreserve bool
-			if bc.shouldPreserve != nil {
-				currentPreserve, blockPreserve = bc.shouldPreserve(currentBlock), bc.shouldPreserve(block)
-			}
-			reorg = !currentPreserve && (blockPreserve || mrand.Float64() < 0.5)
-		}
+	return externTd, nil
+}
+
+// WriteBlockWithoutHead writes the block and all associated state to the database
+// without adding it to the canonical chain, whatever its total difficulty. It is
+// used by the engines certifying blocks before they commit them, such as chained
+// hotstuff, which later make them canonical through SetCanonical.
+func (bc *BlockChain) WriteBlockWithoutHead(block *types.Block, receipts []*types.Receipt, state *state.StateDB) error {
+	if !bc.chainmu.TryLock() {
+		return errInsertionInterrupted
 	}
-	if reorg {
-		// Reorganise the chain if the parent is not the head block
-		if block.ParentHash() != currentBlock.Hash() {
-			if err := bc.reorg(currentBlock, block); err != nil {
-				return NonStatTy, err
-			}
+	defer bc.chainmu.Unlock()
+
+	if bc.insertStopped() {
+		return errInsertionInterrupted
+	}
+	_, err := bc.writeBlockData(block, receipts, state)
+	return err
+}
+
+// SetCanonical makes the given block, written before along with its state, the
+// head of the chain. Its ancestors missing from the canonical chain are added to
+// it too, reorganising the chain if the block doesn't descend from the head.
+func (bc *BlockChain) SetCanonical(block *types.Block) error {
+	if !bc.chainmu.TryLock() {
+		return errChainStopped
+	}
+	defer bc.chainmu.Unlock()
+
+	if bc.GetCanonicalHash(block.NumberU64()) == block.Hash() {
+		return nil
+	}
+	if !bc.HasBlockAndState(block.Hash(), block.NumberU64()) {
+		return fmt.Errorf("canonical block #%d [%x…] is unknown", block.NumberU64(), block.Hash().Bytes()[:4])
+	}
+	// Gather the blocks missing from the canonical chain, newest first
+	var blocks types.Blocks
+	for ancestor := block; bc.GetCanonicalHash(ancestor.NumberU64()) != ancestor.Hash(); {
+		blocks = append(blocks, ancestor)
+		if ancestor = bc.GetBlock(ancestor.ParentHash(), ancestor.NumberU64()-1); ancestor == nil {
+			return consensus.ErrUnknownAncestor
 		}
-		status = CanonStatTy
-	} else {
-		status = SideStatTy
 	}
-	// Set new head.
-	if status == CanonStatTy {
-		bc.writeHeadBlock(block)
+	if current := bc.CurrentBlock(); blocks[len(blocks)-1].ParentHash() != current.Hash() {
+		if err := bc.reorg(current, block); err != nil {
+			return err
+		}
+		blocks = blocks[:1]
 	}
-	bc.futureBlocks.Remove(block.Hash())
+	for i := len(blocks) - 1; i >= 0; i-- {
+		bc.writeHeadBlock(blocks[i])
 
-	if status == CanonStatTy {
-		bc.chainFeed.Send(ChainEvent{Block: block, Hash: block.Hash(), Logs: logs})
+		logs := bc.collectLogs(blocks[i])
+		bc.chainFeed.Send(ChainEvent{Block: blocks[i], Hash: blocks[i].Hash(), Logs: logs})
 		if len(logs) > 0 {
 			bc.logsFeed.Send(logs)
 		}
-		// In theory we should fire a ChainHeadEvent when we inject
-		// a canonical block, but sometimes we can insert a batch of
-		// canonicial blocks. Avoid firing too much ChainHeadEvents,
-		// we will fire an accumulated ChainHeadEvent and disable fire
-		// event here.
-		if emitHeadEvent {
-			bc.chainHeadFeed.Send(ChainHeadEvent{Block: block})
-		}
-	} else {
-		bc.chainSideFeed.Send(ChainSideEvent{Block: block})
 	}
-	return status, nil
+	bc.chainHeadFeed.Send(ChainHeadEvent{Block: block})
+	return nil
+}
+
+// collectLogs retrieves the logs generated by the given stored block.
+func (bc *BlockChain) collectLogs(block *types.Block) []*types.Log {
+	var logs []*types.Log
+	for _, receipt := range rawdb.ReadReceipts(bc.db, block.Hash(), block.NumberU64(), bc.chainConfig) {
+		logs = append(logs, receipt.Logs...)
+	}
+	return logs
 }
 
 // addFutureBlock checks if the block is within the max allowed window to get
@@ -1666,6 +1829,10 @@ func (bc *BlockChain) insertChain(chain types.Blocks, verifySeals bool) (int, er
 
 			lastCanon = block
 
+			// Blocks with a verified QC are final with instant finality
+			if verifySeals && bc.instantFinality {
+				bc.setFinalized(block)
+			}
 			// Only count canonical blocks for GC processing time
 			bc.gcproc += proctime
 
@@ -1935,6 +2102,10 @@ func (bc *BlockChain) reorg(oldBlock, newBlock *types.Block) error {
 			return fmt.Errorf("invalid new chain")
 		}
 	}
+	// Never drop finalized blocks from the canonical chain
+	if finalized := bc.CurrentFinalizedBlock(); finalized != nil && commonBlock.NumberU64() < finalized.NumberU64() {
+		return fmt.Errorf("%w: ancestor %d, finalized %d", ErrFinalizedRewind, commonBlock.NumberU64(), finalized.NumberU64())
+	}
 	// Ensure the user sees large reorgs
 	if len(oldChain) > 0 && len(newChain) > 0 {
 		logFn := log.Info
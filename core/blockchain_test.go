@@ -3336,3 +3336,229 @@ func TestEIP1559Transition(t *testing.T) {
 		t.Fatalf("sender balance incorrect: expected %d, got %d", expected, actual)
 	}
 }
+
+// finalityEngine wraps a fake ethash engine to look like an engine with instant
+// finality, where every imported block carries a quorum certificate.
+type finalityEngine struct {
+	consensus.Engine
+}
+
+func (e *finalityEngine) Start(chain consensus.ChainReader, currentBlock func() *types.Block, getBlockByHash func(hash common.Hash) *types.Block) error {
+	return nil
+}
+
+func (e *finalityEngine) Stop() error { return nil }
+
+func (e *finalityEngine) Address() common.Address { return common.Address{} }
+
+func (e *finalityEngine) CurrentView() (*big.Int, *big.Int, string, bool) {
+	return nil, nil, "", false
+}
+
+func (e *finalityEngine) ValidatorsAt(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	return nil, nil
+}
+
+func (e *finalityEngine) Participants(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	return nil, nil
+}
+
+// Tests that chains driven by an instant finality engine track the finalized
+// block as blocks with verified seals are imported, persist it across restarts
+// and refuse to rewind or reorg below it.
+func TestFinalizedBlock(t *testing.T) {
+	var (
+		engine  = &finalityEngine{ethash.NewFaker()}
+		db      = rawdb.NewMemoryDatabase()
+		genesis = (&Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+	)
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, engine, db, 10, nil)
+	forks, _ := GenerateChain(params.TestChainConfig, blocks[4], engine, db, 10, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{1})
+	})
+	chain, err := NewBlockChain(db, nil, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedBlock(); finalized == nil || finalized.Hash() != genesis.Hash() {
+		t.Fatalf("finalized block mismatch: have %v, want genesis", finalized)
+	}
+	// Blocks imported without verifying their seal are not finalized
+	if _, err := chain.InsertChainWithoutSealVerification(blocks[0]); err != nil {
+		t.Fatalf("failed to insert block without seal verification: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedBlock(); finalized.Hash() != genesis.Hash() {
+		t.Fatalf("unverified block finalized: #%d", finalized.NumberU64())
+	}
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	head := blocks[len(blocks)-1]
+	if finalized := chain.CurrentFinalizedBlock(); finalized.Hash() != head.Hash() {
+		t.Fatalf("finalized block mismatch: have #%d, want #%d", finalized.NumberU64(), head.NumberU64())
+	}
+	if hash := rawdb.ReadFinalizedBlockHash(db); hash != head.Hash() {
+		t.Fatalf("persisted finalized hash mismatch: have %x, want %x", hash, head.Hash())
+	}
+	// Rewinding and reorging below the finalized block must fail
+	if err := chain.SetHead(5); !errors.Is(err, ErrFinalizedRewind) {
+		t.Fatalf("rewind error mismatch: have %v, want %v", err, ErrFinalizedRewind)
+	}
+	if _, err := chain.InsertChain(forks); !errors.Is(err, ErrFinalizedRewind) {
+		t.Fatalf("reorg error mismatch: have %v, want %v", err, ErrFinalizedRewind)
+	}
+	if current := chain.CurrentBlock(); current.Hash() != head.Hash() {
+		t.Fatalf("head block mismatch: have #%d [%x], want #%d [%x]", current.NumberU64(), current.Hash(), head.NumberU64(), head.Hash())
+	}
+	chain.Stop()
+
+	// Restart the chain and check that the finalized block is restored
+	chain, err = NewBlockChain(db, nil, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to recreate tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if finalized := chain.CurrentFinalizedBlock(); finalized == nil || finalized.Hash() != head.Hash() {
+		t.Fatalf("restored finalized block mismatch: have %v, want #%d", finalized, head.NumberU64())
+	}
+}
+
+// Tests that chains driven by the chained hotstuff protocol only finalize the
+// blocks the engine commits, and reorg the blocks above them.
+func TestChainedFinalizedBlock(t *testing.T) {
+	config := *params.TestChainConfig
+	config.HotStuff = &params.HotStuffConfig{Chained: true}
+
+	var (
+		engine  = &finalityEngine{ethash.NewFaker()}
+		db      = rawdb.NewMemoryDatabase()
+		genesis = (&Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+	)
+	blocks, _ := GenerateChain(&config, genesis, engine, db, 10, nil)
+	chain, err := NewBlockChain(db, nil, &config, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if n, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("block %d: failed to insert into chain: %v", n, err)
+	}
+	if finalized := chain.CurrentFinalizedBlock(); finalized != nil {
+		t.Fatalf("written block finalized: #%d", finalized.NumberU64())
+	}
+	if err := chain.SetFinalized(blocks[7]); err != nil {
+		t.Fatalf("failed to finalize block: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedBlock(); finalized.Hash() != blocks[7].Hash() {
+		t.Fatalf("finalized block mismatch: have #%d, want #%d", finalized.NumberU64(), blocks[7].NumberU64())
+	}
+	// The finalized block never moves backwards
+	if err := chain.SetFinalized(blocks[5]); err != nil {
+		t.Fatalf("failed to finalize ancestor: %v", err)
+	}
+	if finalized := chain.CurrentFinalizedBlock(); finalized.Hash() != blocks[7].Hash() {
+		t.Fatalf("finalized block moved backwards: have #%d, want #%d", finalized.NumberU64(), blocks[7].NumberU64())
+	}
+	// Forks below the finalized block are refused, the ones above it accepted
+	forks, _ := GenerateChain(&config, blocks[6], engine, db, 5, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{1})
+	})
+	if _, err := chain.InsertChain(forks); !errors.Is(err, ErrFinalizedRewind) {
+		t.Fatalf("reorg error mismatch: have %v, want %v", err, ErrFinalizedRewind)
+	}
+	forks, _ = GenerateChain(&config, blocks[7], engine, db, 5, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{2})
+	})
+	if n, err := chain.InsertChain(forks); err != nil {
+		t.Fatalf("block %d: failed to reorg above the finalized block: %v", n, err)
+	}
+	if current := chain.CurrentBlock(); current.Hash() != forks[len(forks)-1].Hash() {
+		t.Fatalf("head block mismatch: have #%d [%x], want #%d [%x]", current.NumberU64(), current.Hash(), forks[len(forks)-1].NumberU64(), forks[len(forks)-1].Hash())
+	}
+	// Blocks reorged out of the chain are not finalized
+	if err := chain.SetFinalized(blocks[9]); err == nil {
+		t.Fatalf("non canonical block finalized")
+	}
+	if finalized := chain.CurrentFinalizedBlock(); finalized.Hash() != blocks[7].Hash() {
+		t.Fatalf("finalized block mismatch: have #%d, want #%d", finalized.NumberU64(), blocks[7].NumberU64())
+	}
+}
+
+// Tests that blocks written without setting the head stay off the canonical
+// chain until they are made canonical, along with their missing ancestors.
+func TestSetCanonical(t *testing.T) {
+	var (
+		engine  = ethash.NewFaker()
+		db      = rawdb.NewMemoryDatabase()
+		genesis = (&Genesis{BaseFee: big.NewInt(params.InitialBaseFee)}).MustCommit(db)
+	)
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, engine, db, 4, nil)
+	forks, _ := GenerateChain(params.TestChainConfig, blocks[1], engine, db, 2, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{1})
+	})
+	chain, err := NewBlockChain(db, nil, params.TestChainConfig, engine, vm.Config{}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create tester chain: %v", err)
+	}
+	defer chain.Stop()
+
+	heads := make(chan ChainHeadEvent, 10)
+	sub := chain.SubscribeChainHeadEvent(heads)
+	defer sub.Unsubscribe()
+
+	write := func(block *types.Block) {
+		parent := chain.GetBlock(block.ParentHash(), block.NumberU64()-1)
+		statedb, err := chain.StateAt(parent.Root())
+		if err != nil {
+			t.Fatalf("failed to retrieve parent state: %v", err)
+		}
+		receipts, _, _, err := chain.Processor().Process(block, statedb, vm.Config{})
+		if err != nil {
+			t.Fatalf("failed to process block #%d: %v", block.NumberU64(), err)
+		}
+		if err := chain.WriteBlockWithoutHead(block, receipts, statedb); err != nil {
+			t.Fatalf("failed to write block #%d: %v", block.NumberU64(), err)
+		}
+	}
+	for _, block := range append(blocks, forks...) {
+		write(block)
+	}
+	if current := chain.CurrentBlock(); current.Hash() != genesis.Hash() {
+		t.Fatalf("head moved by written blocks: have #%d [%x]", current.NumberU64(), current.Hash())
+	}
+	if hash := chain.GetCanonicalHash(1); hash != (common.Hash{}) {
+		t.Fatalf("written block made canonical: %x", hash)
+	}
+	// Setting a block canonical adds its ancestors to the chain too
+	if err := chain.SetCanonical(blocks[2]); err != nil {
+		t.Fatalf("failed to set canonical block: %v", err)
+	}
+	for _, block := range blocks[:3] {
+		if hash := chain.GetCanonicalHash(block.NumberU64()); hash != block.Hash() {
+			t.Fatalf("canonical hash #%d mismatch: have %x, want %x", block.NumberU64(), hash, block.Hash())
+		}
+	}
+	if current := chain.CurrentBlock(); current.Hash() != blocks[2].Hash() {
+		t.Fatalf("head block mismatch: have #%d [%x], want #%d [%x]", current.NumberU64(), current.Hash(), blocks[2].NumberU64(), blocks[2].Hash())
+	}
+	if ev := <-heads; ev.Block.Hash() != blocks[2].Hash() {
+		t.Fatalf("head event mismatch: have #%d [%x], want #%d [%x]", ev.Block.NumberU64(), ev.Block.Hash(), blocks[2].NumberU64(), blocks[2].Hash())
+	}
+	// Blocks on another branch are reorged in
+	if err := chain.SetCanonical(forks[1]); err != nil {
+		t.Fatalf("failed to set canonical fork: %v", err)
+	}
+	if current := chain.CurrentBlock(); current.Hash() != forks[1].Hash() {
+		t.Fatalf("head block mismatch: have #%d [%x], want #%d [%x]", current.NumberU64(), current.Hash(), forks[1].NumberU64(), forks[1].Hash())
+	}
+	if hash := chain.GetCanonicalHash(forks[0].NumberU64()); hash != forks[0].Hash() {
+		t.Fatalf("canonical hash #%d mismatch: have %x, want %x", forks[0].NumberU64(), hash, forks[0].Hash())
+	}
+	// Unknown blocks are refused
+	unknown, _ := GenerateChain(params.TestChainConfig, forks[1], engine, db, 1, nil)
+	if err := chain.SetCanonical(unknown[0]); err == nil {
+		t.Fatalf("unknown block made canonical")
+	}
+}
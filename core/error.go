@@ -32,6 +32,10 @@ var (
 	// ErrNoGenesis is returned when there is no Genesis Block.
 	ErrNoGenesis = errors.New("genesis not found in chain")
 
+	// ErrFinalizedRewind is returned when a rewind or a reorg would drop a
+	// finalized block from the canonical chain.
+	ErrFinalizedRewind = errors.New("rewind below finalized block")
+
 	errSideChainReceipts = errors.New("side blocks can't be accepted as ancient chain data")
 )
 
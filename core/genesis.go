@@ -294,6 +294,16 @@ func (g *Genesis) ToBlock(db ethdb.Database) *types.Block {
 	if g.Difficulty == nil {
 		head.Difficulty = params.GenesisDifficulty
 	}
+	if g.Config != nil && g.Config.HotStuff != nil && len(g.Config.HotStuff.Validators) > 0 {
+		// Hotstuff chains record their initial validators in the extra-data,
+		// keeping the vanity of the configured one
+		if err := types.HotstuffHeaderFillWithValidators(head, g.Config.HotStuff.ValidatorAddresses()); err != nil {
+			panic(err)
+		}
+		if g.Mixhash == (common.Hash{}) {
+			head.MixDigest = types.HotstuffDigest
+		}
+	}
 	if g.Config != nil && g.Config.IsLondon(common.Big0) {
 		if g.BaseFee != nil {
 			head.BaseFee = g.BaseFee
@@ -324,6 +334,14 @@ func (g *Genesis) Commit(db ethdb.Database) (*types.Block, error) {
 	if config.Clique != nil && len(block.Extra()) == 0 {
 		return nil, errors.New("can't start clique chain without signers")
 	}
+	if config.HotStuff != nil {
+		if extra, err := types.ExtractHotstuffExtra(block.Header()); err != nil || len(extra.Validators) == 0 {
+			return nil, errors.New("can't start hotstuff chain without validators")
+		}
+		if err := config.HotStuff.CheckRewards(); err != nil {
+			return nil, err
+		}
+	}
 	rawdb.WriteTd(db, block.Hash(), block.NumberU64(), block.Difficulty())
 	rawdb.WriteBlock(db, block)
 	rawdb.WriteReceipts(db, block.Hash(), block.NumberU64(), nil)
@@ -431,19 +449,55 @@ func DeveloperGenesisBlock(period uint64, faucet common.Address) *Genesis {
 		GasLimit:   11500000,
 		BaseFee:    big.NewInt(params.InitialBaseFee),
 		Difficulty: big.NewInt(1),
-		Alloc: map[common.Address]GenesisAccount{
-			common.BytesToAddress([]byte{1}): {Balance: big.NewInt(1)}, // ECRecover
-			common.BytesToAddress([]byte{2}): {Balance: big.NewInt(1)}, // SHA256
-			common.BytesToAddress([]byte{3}): {Balance: big.NewInt(1)}, // RIPEMD
-			common.BytesToAddress([]byte{4}): {Balance: big.NewInt(1)}, // Identity
-			common.BytesToAddress([]byte{5}): {Balance: big.NewInt(1)}, // ModExp
-			common.BytesToAddress([]byte{6}): {Balance: big.NewInt(1)}, // ECAdd
-			common.BytesToAddress([]byte{7}): {Balance: big.NewInt(1)}, // ECScalarMul
-			common.BytesToAddress([]byte{8}): {Balance: big.NewInt(1)}, // ECPairing
-			common.BytesToAddress([]byte{9}): {Balance: big.NewInt(1)}, // BLAKE2b
-			faucet:                           {Balance: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(9))},
+		Alloc:      developerAlloc(faucet),
+	}
+}
+
+// DeveloperHotstuffGenesisBlock returns the 'geth --dev --dev.hotstuff' genesis
+// block, sealed by a single validator. A zero period seals blocks as soon as
+// transactions arrive.
+func DeveloperHotstuffGenesisBlock(period uint64, validator common.Address, publicKey []byte, faucet common.Address) *Genesis {
+	config := *params.AllCliqueProtocolChanges
+	config.Clique = nil
+	config.HotStuff = &params.HotStuffConfig{
+		Period:  period,
+		Epoch:   30000,
+		Instant: period == 0,
+		Validators: []params.HotStuffValidator{
+			{Address: validator, PublicKey: publicKey},
 		},
 	}
+	header := &types.Header{Extra: make([]byte, types.HotstuffExtraVanity)}
+	if err := types.HotstuffHeaderFillWithValidators(header, config.HotStuff.ValidatorAddresses()); err != nil {
+		panic(err)
+	}
+	// Assemble and return the genesis with the precompiles and faucet pre-funded
+	return &Genesis{
+		Config:     &config,
+		ExtraData:  header.Extra,
+		GasLimit:   11500000,
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+		Difficulty: big.NewInt(1),
+		Mixhash:    types.HotstuffDigest,
+		Alloc:      developerAlloc(faucet),
+	}
+}
+
+// developerAlloc returns the developer genesis allocation with the precompiles
+// and the faucet pre-funded.
+func developerAlloc(faucet common.Address) GenesisAlloc {
+	return GenesisAlloc{
+		common.BytesToAddress([]byte{1}): {Balance: big.NewInt(1)}, // ECRecover
+		common.BytesToAddress([]byte{2}): {Balance: big.NewInt(1)}, // SHA256
+		common.BytesToAddress([]byte{3}): {Balance: big.NewInt(1)}, // RIPEMD
+		common.BytesToAddress([]byte{4}): {Balance: big.NewInt(1)}, // Identity
+		common.BytesToAddress([]byte{5}): {Balance: big.NewInt(1)}, // ModExp
+		common.BytesToAddress([]byte{6}): {Balance: big.NewInt(1)}, // ECAdd
+		common.BytesToAddress([]byte{7}): {Balance: big.NewInt(1)}, // ECScalarMul
+		common.BytesToAddress([]byte{8}): {Balance: big.NewInt(1)}, // ECPairing
+		common.BytesToAddress([]byte{9}): {Balance: big.NewInt(1)}, // BLAKE2b
+		faucet:                           {Balance: new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(9))},
+	}
 }
 
 func decodePrealloc(data string) GenesisAlloc {
@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus/ethash"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/params"
@@ -38,6 +39,87 @@ func TestInvalidCliqueConfig(t *testing.T) {
 	}
 }
 
+func TestHotstuffGenesis(t *testing.T) {
+	config := *params.TestChainConfig
+	config.HotStuff = &params.HotStuffConfig{
+		Epoch: 100,
+		Validators: []params.HotStuffValidator{
+			{Address: common.Address{0x01}, PublicKey: []byte{0x01}},
+			{Address: common.Address{0x02}, PublicKey: []byte{0x02}},
+		},
+	}
+	genesis := &Genesis{Config: &config, ExtraData: []byte("vanity")}
+	block, err := genesis.Commit(rawdb.NewMemoryDatabase())
+	if err != nil {
+		t.Fatalf("failed to commit genesis: %v", err)
+	}
+	extra, err := types.ExtractHotstuffExtra(block.Header())
+	if err != nil {
+		t.Fatalf("failed to decode genesis extra-data: %v", err)
+	}
+	if want := config.HotStuff.ValidatorAddresses(); !reflect.DeepEqual(extra.Validators, want) {
+		t.Errorf("genesis validators mismatch: have %v, want %v", extra.Validators, want)
+	}
+	if vanity := block.Extra()[:6]; string(vanity) != "vanity" {
+		t.Errorf("genesis vanity mismatch: have %q, want %q", vanity, "vanity")
+	}
+	if block.MixDigest() != types.HotstuffDigest {
+		t.Errorf("genesis mix digest mismatch: have %x, want %x", block.MixDigest(), types.HotstuffDigest)
+	}
+	// Hotstuff chains can't start without validators
+	config.HotStuff = &params.HotStuffConfig{Epoch: 100}
+	if _, err := genesis.Commit(rawdb.NewMemoryDatabase()); err == nil {
+		t.Fatal("expected error on hotstuff genesis without validators")
+	}
+	// Nor with reward shares above the whole block reward
+	treasury := common.Address{0x03}
+	config.HotStuff = &params.HotStuffConfig{
+		Epoch:         100,
+		Validators:    []params.HotStuffValidator{{Address: common.Address{0x01}, PublicKey: []byte{0x01}}},
+		BlockReward:   big.NewInt(1),
+		ProposerShare: 60,
+		Treasury:      &treasury,
+		TreasuryShare: 50,
+	}
+	if _, err := genesis.Commit(rawdb.NewMemoryDatabase()); err == nil {
+		t.Fatal("expected error on hotstuff genesis with reward shares above 100%")
+	}
+}
+
+func TestDeveloperHotstuffGenesis(t *testing.T) {
+	var (
+		validator = common.Address{0x01}
+		faucet    = common.Address{0x02}
+	)
+	for _, period := range []uint64{0, 5} {
+		genesis := DeveloperHotstuffGenesisBlock(period, validator, []byte{0x01}, faucet)
+		if genesis.Config.Clique != nil {
+			t.Fatalf("period %d: developer hotstuff genesis configures clique", period)
+		}
+		if have, want := genesis.Config.HotStuff.Instant, period == 0; have != want {
+			t.Errorf("period %d: instant sealing mismatch: have %v, want %v", period, have, want)
+		}
+		block, err := genesis.Commit(rawdb.NewMemoryDatabase())
+		if err != nil {
+			t.Fatalf("period %d: failed to commit genesis: %v", period, err)
+		}
+		extra, err := types.ExtractHotstuffExtra(block.Header())
+		if err != nil {
+			t.Fatalf("period %d: failed to decode genesis extra-data: %v", period, err)
+		}
+		if want := []common.Address{validator}; !reflect.DeepEqual(extra.Validators, want) {
+			t.Errorf("period %d: genesis validators mismatch: have %v, want %v", period, extra.Validators, want)
+		}
+		if _, ok := genesis.Alloc[faucet]; !ok {
+			t.Errorf("period %d: faucet not funded", period)
+		}
+	}
+	// The clique developer genesis must stay untouched
+	if genesis := DeveloperGenesisBlock(0, faucet); genesis.Config.HotStuff != nil || genesis.Config.Clique == nil {
+		t.Errorf("clique developer genesis misconfigured: %v", genesis.Config)
+	}
+}
+
 func TestSetupGenesis(t *testing.T) {
 	var (
 		customghash = common.HexToHash("0x89c99d90b79719238d2645c7642f2c9295246e80775b38cfd162b696817fbd50")
@@ -0,0 +1,52 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package rawdb
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// ReadHotstuffSnapshot retrieves the serialized hotstuff validator snapshot
+// created at the given block hash.
+func ReadHotstuffSnapshot(db ethdb.KeyValueReader, hash common.Hash) []byte {
+	data, _ := db.Get(hotstuffSnapshotKey(hash))
+	return data
+}
+
+// WriteHotstuffSnapshot stores the serialized hotstuff validator snapshot
+// created at the given block hash.
+func WriteHotstuffSnapshot(db ethdb.KeyValueWriter, hash common.Hash, snapshot []byte) {
+	if err := db.Put(hotstuffSnapshotKey(hash), snapshot); err != nil {
+		log.Crit("Failed to store hotstuff snapshot", "err", err)
+	}
+}
+
+// ReadHotstuffWAL retrieves the consensus write-ahead log of the hotstuff
+// validator with the given address.
+func ReadHotstuffWAL(db ethdb.KeyValueReader, address common.Address) []byte {
+	data, _ := db.Get(hotstuffWALKey(address))
+	return data
+}
+
+// WriteHotstuffWAL stores the consensus write-ahead log of the hotstuff validator
+// with the given address, syncing it to disk before returning. Stores which
+// can't sync the write are refused, as a validator may not vote without a WAL.
+func WriteHotstuffWAL(db ethdb.KeyValueWriter, address common.Address, wal []byte) error {
+	return putSync(db, hotstuffWALKey(address), wal)
+}
@@ -74,6 +74,12 @@ func (frdb *freezerdb) Freeze(threshold uint64) error {
 	return nil
 }
 
+// PutSync inserts the given value into the key-value store, waiting for the
+// write to be flushed to stable storage.
+func (frdb *freezerdb) PutSync(key []byte, value []byte) error {
+	return putSync(frdb.KeyValueStore, key, value)
+}
+
 // nofreezedb is a database wrapper that disables freezer data retrievals.
 type nofreezedb struct {
 	ethdb.KeyValueStore
@@ -119,6 +125,12 @@ func (db *nofreezedb) Sync() error {
 	return errNotSupported
 }
 
+// PutSync inserts the given value into the key-value store, waiting for the
+// write to be flushed to stable storage.
+func (db *nofreezedb) PutSync(key []byte, value []byte) error {
+	return putSync(db.KeyValueStore, key, value)
+}
+
 func (db *nofreezedb) ReadAncients(fn func(reader ethdb.AncientReader) error) (err error) {
 	// Unlike other ancient-related methods, this method does not return
 	// errNotSupported when invoked.
@@ -135,6 +147,20 @@ func (db *nofreezedb) ReadAncients(fn func(reader ethdb.AncientReader) error) (e
 	return fn(db)
 }
 
+// errSyncNotSupported is returned if a key-value store can't flush a write to
+// stable storage.
+var errSyncNotSupported = errors.New("synced writes are not supported")
+
+// putSync inserts the given value into the store and flushes it to stable
+// storage. Stores without PutSync are refused rather than written to with Put,
+// as their Sync, if any, only flushes the ancient store.
+func putSync(db ethdb.KeyValueWriter, key []byte, value []byte) error {
+	if syncer, ok := db.(ethdb.SyncWriter); ok {
+		return syncer.PutSync(key, value)
+	}
+	return errSyncNotSupported
+}
+
 // NewDatabase creates a high level database on top of a given key-value data
 // store without a freezer moving immutable chain segments into cold storage.
 func NewDatabase(db ethdb.KeyValueStore) ethdb.Database {
@@ -322,6 +348,8 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		preimages       stat
 		bloomBits       stat
 		cliqueSnaps     stat
+		hotstuffSnaps   stat
+		hotstuffWALs    stat
 
 		// Ancient store statistics
 		ancientHeadersSize  common.StorageSize
@@ -381,6 +409,10 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 			bloomBits.Add(size)
 		case bytes.HasPrefix(key, []byte("clique-")) && len(key) == 7+common.HashLength:
 			cliqueSnaps.Add(size)
+		case bytes.HasPrefix(key, hotstuffSnapshotPrefix) && len(key) == len(hotstuffSnapshotPrefix)+common.HashLength:
+			hotstuffSnaps.Add(size)
+		case bytes.HasPrefix(key, hotstuffWALPrefix) && len(key) == len(hotstuffWALPrefix)+common.AddressLength:
+			hotstuffWALs.Add(size)
 		case bytes.HasPrefix(key, []byte("cht-")) ||
 			bytes.HasPrefix(key, []byte("chtIndexV2-")) ||
 			bytes.HasPrefix(key, []byte("chtRootV2-")): // Canonical hash trie
@@ -392,7 +424,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		default:
 			var accounted bool
 			for _, meta := range [][]byte{
-				databaseVersionKey, headHeaderKey, headBlockKey, headFastBlockKey, lastPivotKey,
+				databaseVersionKey, headHeaderKey, headBlockKey, headFastBlockKey, headFinalizedBlockKey, headCertifiedBlockKey, lastPivotKey,
 				fastTrieProgressKey, snapshotDisabledKey, SnapshotRootKey, snapshotJournalKey,
 				snapshotGeneratorKey, snapshotRecoveryKey, txIndexTailKey, fastTxLookupLimitKey,
 				uncleanShutdownKey, badBlockKey,
@@ -442,6 +474,8 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		{"Key-Value store", "Account snapshot", accountSnaps.Size(), accountSnaps.Count()},
 		{"Key-Value store", "Storage snapshot", storageSnaps.Size(), storageSnaps.Count()},
 		{"Key-Value store", "Clique snapshots", cliqueSnaps.Size(), cliqueSnaps.Count()},
+		{"Key-Value store", "Hotstuff snapshots", hotstuffSnaps.Size(), hotstuffSnaps.Count()},
+		{"Key-Value store", "Hotstuff WALs", hotstuffWALs.Size(), hotstuffWALs.Count()},
 		{"Key-Value store", "Singleton metadata", metadata.Size(), metadata.Count()},
 		{"Ancient store", "Headers", ancientHeadersSize.String(), ancients.String()},
 		{"Ancient store", "Bodies", ancientBodiesSize.String(), ancients.String()},
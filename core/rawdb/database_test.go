@@ -15,3 +15,49 @@
 // along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
 
 package rawdb
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+// syncCountingDB is a key-value store without PutSync, counting the flushes of
+// its ancient store.
+type syncCountingDB struct {
+	ethdb.KeyValueStore
+	syncs int
+}
+
+func (db *syncCountingDB) Sync() error {
+	db.syncs++
+	return nil
+}
+
+// Tests that the hotstuff WAL is refused by stores without native synced writes,
+// instead of being written and followed by a flush of the ancient store.
+func TestWriteHotstuffWALFallback(t *testing.T) {
+	db := &syncCountingDB{KeyValueStore: memorydb.New()}
+	address, wal := common.Address{0x01}, []byte{0x02}
+
+	if err := WriteHotstuffWAL(db, address, wal); err != errSyncNotSupported {
+		t.Fatalf("error mismatch: have %v, want %v", err, errSyncNotSupported)
+	}
+	if db.syncs != 0 {
+		t.Fatalf("sync count mismatch: have %d, want 0", db.syncs)
+	}
+	if have := ReadHotstuffWAL(db, address); len(have) != 0 {
+		t.Fatalf("unsynced WAL stored: %x", have)
+	}
+	// Wrapped stores forward to the native synced write, even without a freezer
+	chaindb := NewMemoryDatabase()
+	if err := WriteHotstuffWAL(NewTable(chaindb, "prefix-"), address, wal); err != nil {
+		t.Fatalf("failed to write WAL: %v", err)
+	}
+	if have := ReadHotstuffWAL(NewTable(chaindb, "prefix-"), address); !bytes.Equal(have, wal) {
+		t.Fatalf("table WAL mismatch: have %x, want %x", have, wal)
+	}
+}
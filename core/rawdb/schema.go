@@ -39,6 +39,13 @@ var (
 	// headFastBlockKey tracks the latest known incomplete block's hash during fast sync.
 	headFastBlockKey = []byte("LastFast")
 
+	// headFinalizedBlockKey tracks the latest block finalized by the consensus engine.
+	headFinalizedBlockKey = []byte("LastFinalized")
+
+	// headCertifiedBlockKey tracks the latest block certified by the consensus engine,
+	// which is ahead of the canonical chain until the engine commits it.
+	headCertifiedBlockKey = []byte("LastCertified")
+
 	// lastPivotKey tracks the last pivot block used by fast sync (to reenable on sethead).
 	lastPivotKey = []byte("LastPivot")
 
@@ -93,6 +100,9 @@ var (
 	PreimagePrefix = []byte("secure-key-")      // PreimagePrefix + hash -> preimage
 	configPrefix   = []byte("ethereum-config-") // config prefix for the db
 
+	hotstuffSnapshotPrefix = []byte("hotstuff-snapshot-") // hotstuffSnapshotPrefix + hash -> hotstuff validator snapshot
+	hotstuffWALPrefix      = []byte("hotstuff-wal-")      // hotstuffWALPrefix + address -> hotstuff consensus write-ahead log
+
 	// Chain index prefixes (use `i` + single byte to avoid mixing data types).
 	BloomBitsIndexPrefix = []byte("iB") // BloomBitsIndexPrefix is the data table of a chain indexer to track its progress
 
@@ -230,3 +240,13 @@ func IsCodeKey(key []byte) (bool, []byte) {
 func configKey(hash common.Hash) []byte {
 	return append(configPrefix, hash.Bytes()...)
 }
+
+// hotstuffSnapshotKey = hotstuffSnapshotPrefix + hash
+func hotstuffSnapshotKey(hash common.Hash) []byte {
+	return append(hotstuffSnapshotPrefix, hash.Bytes()...)
+}
+
+// hotstuffWALKey = hotstuffWALPrefix + address
+func hotstuffWALKey(address common.Address) []byte {
+	return append(hotstuffWALPrefix, address.Bytes()...)
+}
@@ -107,6 +107,12 @@ func (t *table) Put(key []byte, value []byte) error {
 	return t.db.Put(append([]byte(t.prefix), key...), value)
 }
 
+// PutSync inserts the given value into the database at a prefixed key, waiting
+// for the write to be flushed to stable storage.
+func (t *table) PutSync(key []byte, value []byte) error {
+	return putSync(t.db, append([]byte(t.prefix), key...), value)
+}
+
 // Delete removes the given prefixed key from the database.
 func (t *table) Delete(key []byte) error {
 	return t.db.Delete(append([]byte(t.prefix), key...))
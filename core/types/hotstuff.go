@@ -19,9 +19,10 @@ package types
 import (
 	"bytes"
 	"errors"
+	"io"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/rlp"
-	"io"
 )
 
 var (
@@ -34,33 +35,134 @@ var (
 
 	// ErrInvalidHotstuffHeaderExtra is returned if the length of extra-data is less than 32 bytes
 	ErrInvalidHotstuffHeaderExtra = errors.New("invalid istanbul header extra-data")
+
+	// ErrInvalidHotstuffBitmap is returned if the length of the participants bitmap does not
+	// match the validator set, or it flags validators beyond the end of the set.
+	ErrInvalidHotstuffBitmap = errors.New("invalid hotstuff participants bitmap")
 )
 
 type HotstuffExtra struct {
-	Validators               []common.Address // consensus participants address of the current block
-	LeaderSeal               []byte           // proposer seal
-	AggregatedValidatorsSeal []byte           // aggregated 2f+1 validators' seals
-	ParticipantsIndex        []int            // index of 2f+1 validators that participate in the seal process
-	Salt                     []byte           // omit empty
+	Validators               []common.Address    // consensus participants address of the current block
+	LeaderSeal               []byte              // proposer seal
+	AggregatedValidatorsSeal []byte              // aggregated 2f+1 validators' seals
+	Participants             HotstuffBitmap      // bitmap of the 2f+1 validators that participate in the seal process
+	Salt                     []byte              // omit empty
+	Vote                     *HotstuffVote       // proposer's vote on the validator set, omit empty
+	Evidence                 []*HotstuffEvidence // equivocations of validators to be slashed, omit empty
+	PublicKeys               [][]byte            // BLS public keys of the checkpoint validators, omit empty
+	Round                    uint64              // round of the view certified by the aggregated seal, omit empty
+}
+
+// HotstuffVote is the vote cast by the proposer of a block to add or remove a
+// validator.
+type HotstuffVote struct {
+	Candidate common.Address // validator being voted on
+	Authorize bool           // whether to add or to remove the candidate
+	PublicKey []byte         // BLS public key of the candidate, only set when adding it
+	Proof     []byte         `rlp:"optional"` // proof of possession of the public key by the candidate, only set when adding it
+}
+
+// HotstuffEvidence proves that a validator signed two conflicting consensus
+// messages for the same view. First and Second are the signed messages as sent
+// by the offender, in ascending byte order so that each equivocation has a
+// single encoding.
+type HotstuffEvidence struct {
+	Offender common.Address // validator who signed both messages
+	First    []byte         // rlp encoding of the first signed message
+	Second   []byte         // rlp encoding of the second signed message
+}
+
+// Hash returns the hash identifying the evidence.
+func (e *HotstuffEvidence) Hash() common.Hash {
+	return rlpHash(e)
+}
+
+// hotstuffExtraRLP is the RLP layout of HotstuffExtra. The fields added after
+// Salt are optional and trailing, so that extra-data encoded before they were
+// introduced still decodes.
+type hotstuffExtraRLP struct {
+	Validators               []common.Address
+	LeaderSeal               []byte
+	AggregatedValidatorsSeal []byte
+	Salt                     []byte
+	Vote                     *HotstuffVote       `rlp:"nil,optional"`
+	Evidence                 []*HotstuffEvidence `rlp:"optional"`
+	PublicKeys               [][]byte            `rlp:"optional"`
+	Participants             []byte              `rlp:"optional"`
+	Round                    uint64              `rlp:"optional"`
 }
 
 // EncodeRLP serializes ist into the Ethereum RLP format.
 func (ist *HotstuffExtra) EncodeRLP(w io.Writer) error {
-	return rlp.Encode(w, []interface{}{
-		ist.Validators,
-		ist.LeaderSeal,
-		ist.AggregatedValidatorsSeal,
-		ist.Salt,
+	return rlp.Encode(w, &hotstuffExtraRLP{
+		Validators:               ist.Validators,
+		LeaderSeal:               ist.LeaderSeal,
+		AggregatedValidatorsSeal: ist.AggregatedValidatorsSeal,
+		Salt:                     ist.Salt,
+		Vote:                     ist.Vote,
+		Evidence:                 ist.Evidence,
+		PublicKeys:               ist.PublicKeys,
+		Participants:             ist.Participants,
+		Round:                    ist.Round,
 	})
 }
 
 // DecodeRLP implements rlp.Decoder, and load the istanbul fields from a RLP stream.
 func (ist *HotstuffExtra) DecodeRLP(s *rlp.Stream) error {
-	extra := new(HotstuffExtra)
+	var extra hotstuffExtraRLP
 	if err := s.Decode(&extra); err != nil {
 		return err
 	}
 	ist.Validators, ist.LeaderSeal, ist.AggregatedValidatorsSeal, ist.Salt = extra.Validators, extra.LeaderSeal, extra.AggregatedValidatorsSeal, extra.Salt
+	ist.Participants, ist.Vote, ist.Evidence = extra.Participants, extra.Vote, extra.Evidence
+	ist.PublicKeys, ist.Round = extra.PublicKeys, extra.Round
+	return nil
+}
+
+// HotstuffBitmap flags validators by their index in the validator set, the
+// validator at index i being flagged by bit i%8 of byte i/8.
+type HotstuffBitmap []byte
+
+// NewHotstuffBitmap creates an empty bitmap for a validator set of the given size.
+func NewHotstuffBitmap(size int) HotstuffBitmap {
+	return make(HotstuffBitmap, (size+7)/8)
+}
+
+// Set flags the validator at the given index, which must be within the bitmap.
+func (b HotstuffBitmap) Set(index int) {
+	b[index/8] |= 1 << (index % 8)
+}
+
+// Has reports whether the validator at the given index is flagged.
+func (b HotstuffBitmap) Has(index int) bool {
+	if index < 0 || index/8 >= len(b) {
+		return false
+	}
+	return b[index/8]&(1<<(index%8)) != 0
+}
+
+// Indices returns the indices of the flagged validators, in ascending order.
+func (b HotstuffBitmap) Indices() []int {
+	var indices []int
+	for i := 0; i < len(b)*8; i++ {
+		if b.Has(i) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// Validate checks that the bitmap is sized for a validator set of the given
+// size, and does not flag any validator beyond it.
+func (b HotstuffBitmap) Validate(size int) error {
+	if len(b) != (size+7)/8 {
+		return ErrInvalidHotstuffBitmap
+	}
+	for i := size; i < len(b)*8; i++ {
+		if b.Has(i) {
+			return ErrInvalidHotstuffBitmap
+		}
+	}
 	return nil
 }
 
@@ -98,6 +200,8 @@ func HotstuffFilteredHeader(h *Header, keepSeal bool) *Header {
 		extra.LeaderSeal = []byte{}
 	}
 	extra.AggregatedValidatorsSeal = []byte{}
+	extra.Participants = []byte{}
+	extra.Round = 0
 	//extra.Salt = []byte{}
 
 	payload, err := rlp.EncodeToBytes(&extra)
@@ -126,6 +230,7 @@ func HotstuffHeaderFillWithValidators(header *Header, vals []common.Address) err
 		Validators:               vals,
 		LeaderSeal:               []byte{},
 		AggregatedValidatorsSeal: []byte{},
+		Participants:             []byte{},
 		Salt:                     []byte{},
 	}
 
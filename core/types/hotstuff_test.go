@@ -0,0 +1,179 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package types
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestHotstuffBitmap(t *testing.T) {
+	bitmap := NewHotstuffBitmap(10)
+	if len(bitmap) != 2 {
+		t.Fatalf("bitmap length mismatch: have %d, want 2", len(bitmap))
+	}
+	bitmap.Set(1)
+	bitmap.Set(8)
+	bitmap.Set(9)
+	if !bytes.Equal(bitmap, []byte{0x02, 0x03}) {
+		t.Fatalf("bitmap mismatch: have %x, want 0203", []byte(bitmap))
+	}
+	if indices := bitmap.Indices(); !reflect.DeepEqual(indices, []int{1, 8, 9}) {
+		t.Fatalf("indices mismatch: have %v, want [1 8 9]", indices)
+	}
+	if bitmap.Has(0) || bitmap.Has(16) || bitmap.Has(-1) {
+		t.Fatal("unset validators flagged")
+	}
+	if err := bitmap.Validate(10); err != nil {
+		t.Fatalf("valid bitmap rejected: %v", err)
+	}
+	if err := bitmap.Validate(9); err != ErrInvalidHotstuffBitmap {
+		t.Fatalf("bitmap flagging validators beyond the set accepted: %v", err)
+	}
+	if err := bitmap.Validate(17); err != ErrInvalidHotstuffBitmap {
+		t.Fatalf("short bitmap accepted: %v", err)
+	}
+}
+
+func TestHotstuffExtraRLP(t *testing.T) {
+	header := &Header{Number: big.NewInt(1)}
+	if err := HotstuffHeaderFillWithValidators(header, []common.Address{{0x01}, {0x02}}); err != nil {
+		t.Fatalf("failed to fill header extra: %v", err)
+	}
+	extra, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to extract header extra: %v", err)
+	}
+	extra.LeaderSeal = []byte{0x01}
+	extra.AggregatedValidatorsSeal = []byte{0x02}
+	extra.Participants = HotstuffBitmap{0x03}
+	extra.Round = 2
+	extra.Vote = &HotstuffVote{Candidate: common.Address{0x03}, Authorize: true, PublicKey: []byte{0x04}}
+
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatalf("failed to encode header extra: %v", err)
+	}
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	decoded, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to decode header extra: %v", err)
+	}
+	if !reflect.DeepEqual(decoded, extra) {
+		t.Fatalf("decoded extra mismatch: have %+v, want %+v", decoded, extra)
+	}
+	// The participants and the certified round are set after sealing, so they are
+	// not part of the seal hash
+	filtered, err := ExtractHotstuffExtra(HotstuffFilteredHeader(header, true))
+	if err != nil {
+		t.Fatalf("failed to decode filtered header extra: %v", err)
+	}
+	if len(filtered.Participants) != 0 || len(filtered.AggregatedValidatorsSeal) != 0 || filtered.Round != 0 {
+		t.Fatalf("filtered header keeps the aggregated seal: %+v", filtered)
+	}
+}
+
+func TestHotstuffExtraEvidenceRLP(t *testing.T) {
+	header := &Header{Number: big.NewInt(1)}
+	if err := HotstuffHeaderFillWithValidators(header, nil); err != nil {
+		t.Fatalf("failed to fill header extra: %v", err)
+	}
+	extra, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to extract header extra: %v", err)
+	}
+	// Evidence is carried without any proposer vote in front of it
+	extra.Evidence = []*HotstuffEvidence{{Offender: common.Address{0x01}, First: []byte{0x02}, Second: []byte{0x03}}}
+
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatalf("failed to encode header extra: %v", err)
+	}
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	decoded, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to decode header extra: %v", err)
+	}
+	if decoded.Vote != nil {
+		t.Fatalf("empty vote decoded: %+v", decoded.Vote)
+	}
+	if !reflect.DeepEqual(decoded.Evidence, extra.Evidence) {
+		t.Fatalf("decoded evidence mismatch: have %+v, want %+v", decoded.Evidence, extra.Evidence)
+	}
+}
+
+func TestHotstuffExtraPublicKeysRLP(t *testing.T) {
+	header := &Header{Number: big.NewInt(1)}
+	if err := HotstuffHeaderFillWithValidators(header, []common.Address{{0x01}, {0x02}}); err != nil {
+		t.Fatalf("failed to fill header extra: %v", err)
+	}
+	extra, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to extract header extra: %v", err)
+	}
+	// Checkpoints carry the consensus keys without any vote or evidence in front of them
+	extra.PublicKeys = [][]byte{{0x03}, {0x04}}
+
+	payload, err := rlp.EncodeToBytes(extra)
+	if err != nil {
+		t.Fatalf("failed to encode header extra: %v", err)
+	}
+	header.Extra = append(header.Extra[:HotstuffExtraVanity], payload...)
+	decoded, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to decode header extra: %v", err)
+	}
+	if decoded.Vote != nil || len(decoded.Evidence) != 0 {
+		t.Fatalf("empty vote or evidence decoded: %+v %+v", decoded.Vote, decoded.Evidence)
+	}
+	if !reflect.DeepEqual(decoded.PublicKeys, extra.PublicKeys) {
+		t.Fatalf("decoded public keys mismatch: have %x, want %x", decoded.PublicKeys, extra.PublicKeys)
+	}
+}
+
+func TestHotstuffExtraLegacyRLP(t *testing.T) {
+	// Extra-data sealed before the optional fields were appended
+	legacy := struct {
+		Validators               []common.Address
+		LeaderSeal               []byte
+		AggregatedValidatorsSeal []byte
+		Salt                     []byte
+	}{
+		Validators:               []common.Address{{0x01}, {0x02}},
+		LeaderSeal:               []byte{0x03},
+		AggregatedValidatorsSeal: []byte{0x04},
+		Salt:                     []byte{0x05},
+	}
+	payload, err := rlp.EncodeToBytes(legacy)
+	if err != nil {
+		t.Fatalf("failed to encode legacy extra: %v", err)
+	}
+	header := &Header{Number: big.NewInt(1), Extra: append(make([]byte, HotstuffExtraVanity), payload...)}
+	decoded, err := ExtractHotstuffExtra(header)
+	if err != nil {
+		t.Fatalf("failed to decode legacy extra: %v", err)
+	}
+	if !reflect.DeepEqual(decoded.Validators, legacy.Validators) || !bytes.Equal(decoded.Salt, legacy.Salt) ||
+		!bytes.Equal(decoded.AggregatedValidatorsSeal, legacy.AggregatedValidatorsSeal) || len(decoded.Participants) != 0 {
+		t.Fatalf("decoded legacy extra mismatch: %+v", decoded)
+	}
+}
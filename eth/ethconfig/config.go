@@ -27,6 +27,7 @@ import (
 	"runtime"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/external"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/consensus/clique"
@@ -215,7 +216,19 @@ func CreateConsensusEngine(stack *node.Node, chainConfig *params.ChainConfig, co
 		return clique.New(chainConfig.Clique, db)
 	}
 	if chainConfig.HotStuff != nil {
-		return hotstuff.New(stack.Config().NodeKey(), stack.Config().ConsensusKey(), config2.DefaultBasicConfig, db)
+		if endpoint := stack.Config().ConsensusSigner; endpoint != "" {
+			signer, err := external.NewConsensusSigner(endpoint)
+			if err != nil {
+				log.Crit("Failed to connect to consensus signer", "endpoint", endpoint, "err", err)
+			}
+			log.Info("Using consensus signer", "endpoint", endpoint, "validator", signer.Address())
+			engine, err := hotstuff.NewWithRemoteSigner(signer, config2.FromChainConfig(chainConfig.HotStuff), db)
+			if err != nil {
+				log.Crit("Failed to create hotstuff engine", "err", err)
+			}
+			return engine
+		}
+		return hotstuff.New(stack.Config().NodeKey(), stack.Config().ConsensusKey(), config2.FromChainConfig(chainConfig.HotStuff), db)
 	}
 	// Otherwise assume proof-of-work
 	switch config.PowMode {
@@ -135,6 +135,18 @@ func (f *Filter) Logs(ctx context.Context) ([]*types.Log, error) {
 	}
 	head := header.Number.Uint64()
 
+	if f.begin == rpc.FinalizedBlockNumber.Int64() || f.end == rpc.FinalizedBlockNumber.Int64() {
+		finalized, err := f.backend.HeaderByNumber(ctx, rpc.FinalizedBlockNumber)
+		if err != nil {
+			return nil, err
+		}
+		if f.begin == rpc.FinalizedBlockNumber.Int64() {
+			f.begin = finalized.Number.Int64()
+		}
+		if f.end == rpc.FinalizedBlockNumber.Int64() {
+			f.end = finalized.Number.Int64()
+		}
+	}
 	if f.begin == -1 {
 		f.begin = int64(head)
 	}
@@ -25,6 +25,7 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/forkid"
 	"github.com/ethereum/go-ethereum/core/types"
@@ -228,6 +229,11 @@ func newHandler(config *handlerConfig) (*handler, error) {
 	}
 	h.txFetcher = fetcher.NewTxFetcher(h.txpool.Has, h.txpool.AddRemotes, fetchTx)
 	h.chainSync = newChainSyncer(h)
+
+	// Let consensus engines exchanging their own messages reach the peers
+	if handler, ok := h.chain.Engine().(consensus.Handler); ok {
+		handler.SetBroadcaster(h)
+	}
 	return h, nil
 }
 
@@ -462,6 +468,18 @@ func (h *handler) BroadcastBlock(block *types.Block, propagate bool) {
 	}
 }
 
+// FindPeers retrieves the connected `eth` peers whose node keys derive one of
+// the given addresses, implementing consensus.Broadcaster.
+func (h *handler) FindPeers(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	return h.peers.peersWithAddresses(targets)
+}
+
+// Peers retrieves all the connected `eth` peers by the addresses their node keys
+// derive, implementing consensus.Broadcaster.
+func (h *handler) Peers() map[common.Address]consensus.Peer {
+	return h.peers.peersByAddress()
+}
+
 // BroadcastTransactions will propagate a batch of transactions
 // - To a square root of all peers
 // - And, separately, as announcements to all peers which are not known to
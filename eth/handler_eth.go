@@ -24,8 +24,10 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -99,6 +101,9 @@ func (h *ethHandler) Handle(peer *eth.Peer, packet eth.Packet) error {
 	case *eth.PooledTransactionsPacket:
 		return h.txFetcher.Enqueue(peer.ID(), *packet, true)
 
+	case *eth.HotstuffPacket:
+		return h.handleConsensus(peer, *packet)
+
 	default:
 		return fmt.Errorf("unexpected eth packet type: %T", packet)
 	}
@@ -216,3 +221,17 @@ func (h *ethHandler) handleBlockBroadcast(peer *eth.Peer, block *types.Block, td
 	}
 	return nil
 }
+
+// handleConsensus is invoked from a peer's message handler when it transmits a
+// consensus message for the local engine to process.
+func (h *ethHandler) handleConsensus(peer *eth.Peer, payload []byte) error {
+	handler, ok := h.chain.Engine().(consensus.Handler)
+	if !ok {
+		return fmt.Errorf("unexpected consensus message from %s", peer.ID())
+	}
+	pubkey := peer.Node().Pubkey()
+	if pubkey == nil {
+		return fmt.Errorf("unknown node key of consensus peer %s", peer.ID())
+	}
+	return handler.HandleMsg(crypto.PubkeyToAddress(*pubkey), payload)
+}
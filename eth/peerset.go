@@ -22,6 +22,8 @@ import (
 	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
 	"github.com/ethereum/go-ethereum/p2p"
@@ -211,6 +213,40 @@ func (ps *peerSet) peersWithoutTransaction(hash common.Hash) []*ethPeer {
 	return list
 }
 
+// peersWithAddresses retrieves the peers whose node keys derive one of the given
+// addresses, keyed by that address.
+func (ps *peerSet) peersWithAddresses(targets map[common.Address]bool) map[common.Address]consensus.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	found := make(map[common.Address]consensus.Peer)
+	for _, p := range ps.peers {
+		pubkey := p.Node().Pubkey()
+		if pubkey == nil {
+			continue
+		}
+		if addr := crypto.PubkeyToAddress(*pubkey); targets[addr] {
+			found[addr] = p
+		}
+	}
+	return found
+}
+
+// peersByAddress retrieves all the peers, keyed by the address their node keys
+// derive.
+func (ps *peerSet) peersByAddress() map[common.Address]consensus.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	peers := make(map[common.Address]consensus.Peer)
+	for _, p := range ps.peers {
+		if pubkey := p.Node().Pubkey(); pubkey != nil {
+			peers[crypto.PubkeyToAddress(*pubkey)] = p
+		}
+	}
+	return peers
+}
+
 // len returns if the current number of `eth` peers in the set. Since the `snap`
 // peers are tied to the existence of an `eth` connection, that will always be a
 // subset of `eth`.
@@ -186,6 +186,7 @@ var eth66 = map[uint64]msgHandler{
 	ReceiptsMsg:                   handleReceipts66,
 	GetPooledTransactionsMsg:      handleGetPooledTransactions66,
 	PooledTransactionsMsg:         handlePooledTransactions66,
+	HotstuffMsg:                   handleHotstuff,
 }
 
 // handleMessage is invoked whenever an inbound message is received from a remote
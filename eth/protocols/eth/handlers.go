@@ -411,3 +411,12 @@ func handlePooledTransactions66(backend Backend, msg Decoder, peer *Peer) error
 
 	return backend.Handle(peer, &txs.PooledTransactionsPacket)
 }
+
+func handleHotstuff(backend Backend, msg Decoder, peer *Peer) error {
+	// Consensus messages are opaque to the protocol, hand them over untouched
+	var payload HotstuffPacket
+	if err := msg.Decode(&payload); err != nil {
+		return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+	}
+	return backend.Handle(peer, &payload)
+}
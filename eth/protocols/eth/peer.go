@@ -425,6 +425,12 @@ func (p *Peer) RequestTxs(hashes []common.Hash) error {
 	})
 }
 
+// Send writes a consensus engine message with the given code to the peer. It
+// makes the peer usable by engines through consensus.Peer.
+func (p *Peer) Send(msgcode uint64, data interface{}) error {
+	return p2p.Send(p.rw, msgcode, data)
+}
+
 // knownCache is a cache for known hashes.
 type knownCache struct {
 	hashes mapset.Set
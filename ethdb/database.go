@@ -37,6 +37,14 @@ type KeyValueWriter interface {
 	Delete(key []byte) error
 }
 
+// SyncWriter wraps the PutSync method of a backing data store. It is optional,
+// durable writes to stores not implementing it fail.
+type SyncWriter interface {
+	// PutSync inserts the given value into the key-value data store, and only
+	// returns once the write is flushed to stable storage.
+	PutSync(key []byte, value []byte) error
+}
+
 // Stater wraps the Stat method of a backing data store.
 type Stater interface {
 	// Stat returns a particular internal stat of the database.
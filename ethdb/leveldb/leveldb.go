@@ -199,6 +199,12 @@ func (db *Database) Put(key []byte, value []byte) error {
 	return db.db.Put(key, value, nil)
 }
 
+// PutSync inserts the given value into the key-value store, waiting for the
+// write to be synced to disk.
+func (db *Database) PutSync(key []byte, value []byte) error {
+	return db.db.Put(key, value, &opt.WriteOptions{Sync: true})
+}
+
 // Delete removes the key from the key-value store.
 func (db *Database) Delete(key []byte) error {
 	return db.db.Delete(key, nil)
@@ -109,6 +109,12 @@ func (db *Database) Put(key []byte, value []byte) error {
 	return nil
 }
 
+// PutSync inserts the given value into the key-value store. There is nothing
+// to flush in a memory database, so it is the same as Put.
+func (db *Database) PutSync(key []byte, value []byte) error {
+	return db.Put(key, value)
+}
+
 // Delete removes the key from the key-value store.
 func (db *Database) Delete(key []byte) error {
 	db.lock.Lock()
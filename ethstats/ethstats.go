@@ -43,6 +43,7 @@ import (
 	"github.com/ethereum/go-ethereum/miner"
 	"github.com/ethereum/go-ethereum/node"
 	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/gorilla/websocket"
 )
@@ -65,7 +66,9 @@ type backend interface {
 	SubscribeNewTxsEvent(ch chan<- core.NewTxsEvent) event.Subscription
 	CurrentHeader() *types.Header
 	HeaderByNumber(ctx context.Context, number rpc.BlockNumber) (*types.Header, error)
+	HeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
 	GetTd(ctx context.Context, hash common.Hash) *big.Int
+	ChainConfig() *params.ChainConfig
 	Stats() (pending int, queued int)
 	SyncProgress() ethereum.SyncProgress
 }
@@ -576,6 +579,8 @@ type blockStats struct {
 	TxHash     common.Hash    `json:"transactionsRoot"`
 	Root       common.Hash    `json:"stateRoot"`
 	Uncles     uncleStats     `json:"uncles"`
+
+	Participants int `json:"participants,omitempty"` // Number of validators in the QC of a hotstuff block
 }
 
 // txStats is the information to report about individual transactions.
@@ -651,6 +656,14 @@ func (s *Service) assembleBlockStats(block *types.Block) *blockStats {
 	// Assemble and return the block stats
 	author, _ := s.engine.Author(header)
 
+	var participants int
+	if hotstuff, ok := s.engine.(consensus.Hotstuff); ok && header.Number.Sign() > 0 {
+		signers, err := hotstuff.Participants(chainReader{s.backend}, header)
+		if err != nil {
+			log.Debug("Failed to retrieve block participants", "number", header.Number, "err", err)
+		}
+		participants = len(signers)
+	}
 	return &blockStats{
 		Number:     header.Number,
 		Hash:       header.Hash(),
@@ -665,6 +678,8 @@ func (s *Service) assembleBlockStats(block *types.Block) *blockStats {
 		TxHash:     header.TxHash,
 		Root:       header.Root,
 		Uncles:     uncles,
+
+		Participants: participants,
 	}
 }
 
@@ -759,6 +774,18 @@ type nodeStats struct {
 	Peers    int  `json:"peers"`
 	GasPrice int  `json:"gasPrice"`
 	Uptime   int  `json:"uptime"`
+
+	Consensus *consensusStats `json:"consensus,omitempty"`
+}
+
+// consensusStats is the information to report about the hotstuff consensus.
+type consensusStats struct {
+	Address    common.Address   `json:"address"`    // Validator address of the local node
+	Validator  bool             `json:"validator"`  // Whether the local node is in the validator set
+	Validators []common.Address `json:"validators"` // Validators sealing the next block
+	Height     *big.Int         `json:"height,omitempty"`
+	Round      *big.Int         `json:"round,omitempty"`
+	Phase      string           `json:"phase,omitempty"`
 }
 
 // reportStats retrieves various stats about the node at the networking and
@@ -789,6 +816,11 @@ func (s *Service) reportStats(conn *connWrapper) error {
 		sync := s.backend.SyncProgress()
 		syncing = s.backend.CurrentHeader().Number.Uint64() >= sync.HighestBlock
 	}
+	// Gather the validators and the view of a hotstuff consensus
+	var consensusInfo *consensusStats
+	if hotstuff, ok := s.engine.(consensus.Hotstuff); ok {
+		consensusInfo = s.assembleConsensusStats(hotstuff)
+	}
 	// Assemble the node stats and send it to the server
 	log.Trace("Sending node details to ethstats")
 
@@ -802,6 +834,8 @@ func (s *Service) reportStats(conn *connWrapper) error {
 			GasPrice: gasprice,
 			Syncing:  syncing,
 			Uptime:   100,
+
+			Consensus: consensusInfo,
 		},
 	}
 	report := map[string][]interface{}{
@@ -809,3 +843,52 @@ func (s *Service) reportStats(conn *connWrapper) error {
 	}
 	return conn.WriteJSON(report)
 }
+
+// assembleConsensusStats retrieves the validator set at the current head and
+// the view the local validator is working on.
+func (s *Service) assembleConsensusStats(hotstuff consensus.Hotstuff) *consensusStats {
+	stats := &consensusStats{
+		Address:    hotstuff.Address(),
+		Validators: []common.Address{},
+	}
+	validators, err := hotstuff.ValidatorsAt(chainReader{s.backend}, s.backend.CurrentHeader())
+	if err != nil {
+		log.Debug("Failed to retrieve validators", "err", err)
+	}
+	for _, validator := range validators {
+		stats.Validators = append(stats.Validators, validator)
+		if validator == stats.Address {
+			stats.Validator = true
+		}
+	}
+	if height, round, phase, ok := hotstuff.CurrentView(); ok {
+		stats.Height, stats.Round, stats.Phase = height, round, phase
+	}
+	return stats
+}
+
+// chainReader adapts the backend to the header reader the consensus engine
+// retrieves its validator snapshots with.
+type chainReader struct {
+	backend backend
+}
+
+func (r chainReader) Config() *params.ChainConfig  { return r.backend.ChainConfig() }
+func (r chainReader) CurrentHeader() *types.Header { return r.backend.CurrentHeader() }
+
+func (r chainReader) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := r.GetHeaderByHash(hash); header != nil && header.Number.Uint64() == number {
+		return header
+	}
+	return nil
+}
+
+func (r chainReader) GetHeaderByNumber(number uint64) *types.Header {
+	header, _ := r.backend.HeaderByNumber(context.Background(), rpc.BlockNumber(number))
+	return header
+}
+
+func (r chainReader) GetHeaderByHash(hash common.Hash) *types.Header {
+	header, _ := r.backend.HeaderByHash(context.Background(), hash)
+	return header
+}
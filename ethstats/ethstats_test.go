@@ -1,8 +1,14 @@
 package ethstats
 
 import (
+	"math/big"
+	"reflect"
 	"strconv"
 	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
 )
 
 func TestParseEthstatsURL(t *testing.T) {
@@ -65,3 +71,59 @@ func TestParseEthstatsURL(t *testing.T) {
 	}
 
 }
+
+// testHotstuff is a hotstuff engine reporting a fixed validator set and view.
+type testHotstuff struct {
+	consensus.Hotstuff
+	address    common.Address
+	validators []common.Address
+	running    bool
+}
+
+func (e *testHotstuff) Address() common.Address { return e.address }
+
+func (e *testHotstuff) CurrentView() (*big.Int, *big.Int, string, bool) {
+	if !e.running {
+		return nil, nil, "", false
+	}
+	return big.NewInt(8), big.NewInt(1), "Prepared", true
+}
+
+func (e *testHotstuff) ValidatorsAt(chain consensus.ChainHeaderReader, header *types.Header) ([]common.Address, error) {
+	return e.validators, nil
+}
+
+// testBackend is a backend whose chain only has a head.
+type testBackend struct {
+	backend
+}
+
+func (b *testBackend) CurrentHeader() *types.Header {
+	return &types.Header{Number: big.NewInt(7)}
+}
+
+func TestConsensusStats(t *testing.T) {
+	validators := []common.Address{common.HexToAddress("0x01"), common.HexToAddress("0x02")}
+	s := &Service{backend: new(testBackend)}
+
+	stats := s.assembleConsensusStats(&testHotstuff{address: validators[1], validators: validators, running: true})
+	want := &consensusStats{
+		Address:    validators[1],
+		Validator:  true,
+		Validators: validators,
+		Height:     big.NewInt(8),
+		Round:      big.NewInt(1),
+		Phase:      "Prepared",
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("validator stats mismatch: have %+v, want %+v", stats, want)
+	}
+	stats = s.assembleConsensusStats(&testHotstuff{address: common.HexToAddress("0x03"), validators: validators})
+	want = &consensusStats{
+		Address:    common.HexToAddress("0x03"),
+		Validators: validators,
+	}
+	if !reflect.DeepEqual(stats, want) {
+		t.Errorf("observer stats mismatch: have %+v, want %+v", stats, want)
+	}
+}
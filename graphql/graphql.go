@@ -49,6 +49,10 @@ func (b *Long) UnmarshalGraphQL(input interface{}) error {
 	var err error
 	switch input := input.(type) {
 	case string:
+		if input == "finalized" {
+			*b = Long(rpc.FinalizedBlockNumber)
+			return nil
+		}
 		// uncomment to support hex values
 		//if strings.HasPrefix(input, "0x") {
 		//	// apply leniency and support hex representations of longs.
@@ -1058,10 +1062,10 @@ func (r *Resolver) Block(ctx context.Context, args struct {
 }) (*Block, error) {
 	var block *Block
 	if args.Number != nil {
-		if *args.Number < 0 {
+		number := rpc.BlockNumber(*args.Number)
+		if number < 0 && number != rpc.FinalizedBlockNumber {
 			return nil, nil
 		}
-		number := rpc.BlockNumber(*args.Number)
 		numberOrHash := rpc.BlockNumberOrHashWithNumber(number)
 		block = &Block{
 			backend:      r.backend,
@@ -322,7 +322,8 @@ const schema string = `
 
     type Query {
         # Block fetches an Ethereum block by number or by hash. If neither is
-        # supplied, the most recent known block is returned.
+        # supplied, the most recent known block is returned. The number may also
+        # be the string "finalized" to fetch the latest finalized block.
         block(number: Long, hash: Bytes32): Block
         # Blocks returns all the blocks between two numbers, inclusive. If
         # to is not supplied, it defaults to the most recent known block.
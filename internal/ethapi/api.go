@@ -2019,8 +2019,9 @@ func (api *PrivateDebugAPI) ChaindbCompact() error {
 }
 
 // SetHead rewinds the head of the blockchain to a previous block.
-func (api *PrivateDebugAPI) SetHead(number hexutil.Uint64) {
-	api.b.SetHead(uint64(number))
+// Finalized blocks can't be rewound.
+func (api *PrivateDebugAPI) SetHead(number hexutil.Uint64) error {
+	return api.b.SetHead(uint64(number))
 }
 
 // PublicNetAPI offers network related RPC methods
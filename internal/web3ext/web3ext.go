@@ -21,6 +21,7 @@ var Modules = map[string]string{
 	"admin":    AdminJs,
 	"clique":   CliqueJs,
 	"ethash":   EthashJs,
+	"hotstuff": HotstuffJs,
 	"debug":    DebugJs,
 	"eth":      EthJs,
 	"miner":    MinerJs,
@@ -89,6 +90,69 @@ web3._extend({
 });
 `
 
+const HotstuffJs = `
+web3._extend({
+	property: 'hotstuff',
+	methods: [
+		new web3._extend.Method({
+			name: 'getValidators',
+			call: 'hotstuff_getValidators',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getValidatorsAtHash',
+			call: 'hotstuff_getValidatorsAtHash',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getCurrentView',
+			call: 'hotstuff_getCurrentView',
+			params: 0
+		}),
+		new web3._extend.Method({
+			name: 'getProposer',
+			call: 'hotstuff_getProposer',
+			params: 1
+		}),
+		new web3._extend.Method({
+			name: 'getQuorumCertificate',
+			call: 'hotstuff_getQuorumCertificate',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getSigners',
+			call: 'hotstuff_getSigners',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'status',
+			call: 'hotstuff_status',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'propose',
+			call: 'hotstuff_propose',
+			params: 4
+		}),
+		new web3._extend.Method({
+			name: 'discard',
+			call: 'hotstuff_discard',
+			params: 1
+		}),
+	],
+	properties: [
+		new web3._extend.Property({
+			name: 'proposals',
+			getter: 'hotstuff_proposals'
+		}),
+	]
+});
+`
+
 const EthashJs = `
 web3._extend({
 	property: 'ethash',
@@ -376,13 +440,13 @@ web3._extend({
 			name: 'traceBlock',
 			call: 'debug_traceBlock',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'traceBlockFromFile',
 			call: 'debug_traceBlockFromFile',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'traceBadBlock',
@@ -394,19 +458,19 @@ web3._extend({
 			name: 'standardTraceBadBlockToFile',
 			call: 'debug_standardTraceBadBlockToFile',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'intermediateRoots',
 			call: 'debug_intermediateRoots',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'standardTraceBlockToFile',
 			call: 'debug_standardTraceBlockToFile',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'traceBlockByNumber',
@@ -418,13 +482,13 @@ web3._extend({
 			name: 'traceBlockByHash',
 			call: 'debug_traceBlockByHash',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'traceTransaction',
 			call: 'debug_traceTransaction',
 			params: 2,
-			inputFormatter: [null, null]
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter]
 		}),
 		new web3._extend.Method({
 			name: 'traceCall',
@@ -452,7 +516,7 @@ web3._extend({
 			name: 'getModifiedAccountsByNumber',
 			call: 'debug_getModifiedAccountsByNumber',
 			params: 2,
-			inputFormatter: [null, null],
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter, web3._extend.formatters.inputBlockNumberFormatter],
 		}),
 		new web3._extend.Method({
 			name: 'getModifiedAccountsByHash',
@@ -188,6 +188,14 @@ func (p *peerCommons) Head() (hash common.Hash) {
 	return p.headInfo.Hash
 }
 
+// HeadNumber retrieves the number of the current head block of the peer.
+func (p *peerCommons) HeadNumber() uint64 {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.headInfo.Number
+}
+
 // Td retrieves the current total difficulty of a peer.
 func (p *peerCommons) Td() *big.Int {
 	p.lock.RLock()
@@ -19,17 +19,23 @@ package les
 import (
 	"context"
 	"errors"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
 	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/les/downloader"
 	"github.com/ethereum/go-ethereum/light"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/params"
 )
 
-var errInvalidCheckpoint = errors.New("invalid advertised checkpoint")
+var (
+	errInvalidCheckpoint = errors.New("invalid advertised checkpoint")
+	errMissingCheckpoint = errors.New("missing local epoch checkpoint")
+)
 
 const (
 	// lightSync starts syncing from the current highest block.
@@ -192,6 +198,17 @@ func (h *clientHandler) synchronise(peer *serverPeer) {
 		}
 	}
 
+	// Engines whose validators only change at epoch checkpoints skip to the last
+	// checkpoint of the peer, the headers of the last epoch are synced afterwards
+	if verifier, ok := h.backend.engine.(checkpointVerifier); ok {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := h.syncEpochCheckpoints(ctx, peer, verifier); err != nil {
+			log.Debug("Epoch checkpoint syncing failed", "peer", peer.id, "err", err)
+			h.removePeer(peer.id)
+			return
+		}
+	}
 	if h.syncStart != nil {
 		h.syncStart(h.backend.blockchain.CurrentHeader())
 	}
@@ -202,3 +219,57 @@ func (h *clientHandler) synchronise(peer *serverPeer) {
 	}
 	log.Debug("Synchronise finished", "elapsed", common.PrettyDuration(time.Since(start)))
 }
+
+// checkpointVerifier is implemented by the consensus engines whose validator set
+// only changes at epoch checkpoints, such as hotstuff. Each checkpoint is sealed
+// by the validators recorded in the previous one.
+type checkpointVerifier interface {
+	// Epoch returns the number of blocks between two checkpoints.
+	Epoch(chain consensus.ChainHeaderReader) uint64
+
+	// VerifyCheckpoint checks that the header is the checkpoint following the
+	// trusted one, sealed by the validators the trusted checkpoint records.
+	VerifyCheckpoint(chain consensus.ChainHeaderReader, trusted, header *types.Header) error
+}
+
+// syncEpochCheckpoints moves the local chain to the last epoch checkpoint of the
+// peer, retrieving and verifying the checkpoint headers only, each against the
+// validators of the previous one.
+func (h *clientHandler) syncEpochCheckpoints(ctx context.Context, peer *serverPeer, verifier checkpointVerifier) error {
+	var (
+		chain    = h.backend.blockchain
+		epoch    = verifier.Epoch(chain)
+		head     = chain.CurrentHeader().Number.Uint64()
+		peerHead = peer.HeadNumber()
+		wrapPeer = &peerConnection{handler: h, peer: peer}
+	)
+	trusted := chain.GetHeaderByNumber(head - head%epoch)
+	if trusted == nil {
+		return errMissingCheckpoint
+	}
+	td := chain.GetTd(trusted.Hash(), trusted.Number.Uint64())
+	if td == nil {
+		return errMissingCheckpoint
+	}
+	for trusted.Number.Uint64()+epoch <= peerHead {
+		origin := trusted.Number.Uint64() + epoch
+		amount := (peerHead-origin)/epoch + 1
+		if amount > MaxHeaderFetch {
+			amount = MaxHeaderFetch
+		}
+		headers, err := wrapPeer.RetrieveHeadersByNumber(ctx, origin, int(amount), int(epoch-1))
+		if err != nil {
+			return err
+		}
+		for _, header := range headers {
+			if err := verifier.VerifyCheckpoint(chain, trusted, header); err != nil {
+				return err
+			}
+			td = new(big.Int).Add(td, new(big.Int).Mul(header.Difficulty, new(big.Int).SetUint64(epoch)))
+			chain.InsertTrustedCheckpoint(header, td)
+			trusted = header
+		}
+		log.Debug("Synced epoch checkpoints", "peer", peer.id, "count", len(headers), "number", trusted.Number, "hash", trusted.Hash())
+	}
+	return nil
+}
@@ -523,6 +523,32 @@ func (lc *LightChain) SyncCheckpoint(ctx context.Context, checkpoint *params.Tru
 	return false
 }
 
+// InsertTrustedCheckpoint makes the header, verified by the caller without its
+// ancestors, the head of the chain. It lets the light clients of consensus engines
+// whose validators only change at epoch checkpoints skip from one checkpoint to
+// the next.
+func (lc *LightChain) InsertTrustedCheckpoint(header *types.Header, td *big.Int) {
+	lc.chainmu.Lock()
+	defer lc.chainmu.Unlock()
+
+	// Ensure the chain didn't move past the checkpoint while verifying it
+	if lc.hc.CurrentHeader().Number.Uint64() >= header.Number.Uint64() {
+		return
+	}
+	hash, number := header.Hash(), header.Number.Uint64()
+
+	batch := lc.chainDb.NewBatch()
+	rawdb.WriteTd(batch, hash, number, td)
+	rawdb.WriteHeader(batch, header)
+	rawdb.WriteCanonicalHash(batch, hash, number)
+	rawdb.WriteHeadHeaderHash(batch, hash)
+	if err := batch.Write(); err != nil {
+		log.Crit("Failed to write trusted checkpoint", "err", err)
+	}
+	lc.hc.SetCurrentHeader(header)
+	log.Info("Updated latest header based on trusted checkpoint", "number", number, "hash", hash, "age", common.PrettyAge(time.Unix(int64(header.Time), 0)))
+}
+
 // LockChain locks the chain mutex for reading so that multiple canonical hashes can be
 // retrieved while it is guaranteed that they belong to the same version of the chain
 func (lc *LightChain) LockChain() {
@@ -140,6 +140,8 @@ type worker struct {
 	chainHeadSub event.Subscription
 	chainSideCh  chan core.ChainSideEvent
 	chainSideSub event.Subscription
+	certifiedCh  chan *types.Block
+	certifiedSub event.Subscription
 
 	// Channels
 	newWorkCh          chan *newWorkReq
@@ -206,6 +208,7 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 		txsCh:              make(chan core.NewTxsEvent, txChanSize),
 		chainHeadCh:        make(chan core.ChainHeadEvent, chainHeadChanSize),
 		chainSideCh:        make(chan core.ChainSideEvent, chainSideChanSize),
+		certifiedCh:        make(chan *types.Block, chainHeadChanSize),
 		newWorkCh:          make(chan *newWorkReq),
 		taskCh:             make(chan *task),
 		resultCh:           make(chan *types.Block, resultQueueSize),
@@ -219,6 +222,10 @@ func newWorker(config *Config, chainConfig *params.ChainConfig, engine consensus
 	// Subscribe events for blockchain
 	worker.chainHeadSub = eth.BlockChain().SubscribeChainHeadEvent(worker.chainHeadCh)
 	worker.chainSideSub = eth.BlockChain().SubscribeChainSideEvent(worker.chainSideCh)
+	// Subscribe the blocks certified ahead of the canonical chain, which are extended
+	if certified, ok := engine.(consensus.CertifiedChain); ok {
+		worker.certifiedSub = certified.SubscribeCertifiedHead(worker.certifiedCh)
+	}
 
 	// Sanitize recommit interval if the user-specified one is too short.
 	recommit := worker.config.Recommit
@@ -306,7 +313,7 @@ func (w *worker) pendingBlockAndReceipts() (*types.Block, types.Receipts) {
 func (w *worker) start() {
 	atomic.StoreInt32(&w.running, 1)
 	if hotstuff, ok := w.engine.(consensus.Hotstuff); ok {
-		hotstuff.Start(w.chain, w.chain.CurrentBlock)
+		hotstuff.Start(w.chain, w.chain.CurrentBlock, w.chain.GetBlockByHash)
 	}
 
 	w.startCh <- struct{}{}
@@ -322,6 +329,15 @@ func (w *worker) isRunning() bool {
 	return atomic.LoadInt32(&w.running) == 1
 }
 
+// isInstant returns an indicator whether the consensus engine only seals blocks
+// once transactions arrive, i.e. 0 period clique or instant hotstuff.
+func (w *worker) isInstant() bool {
+	if w.chainConfig.Clique != nil {
+		return w.chainConfig.Clique.Period == 0
+	}
+	return w.chainConfig.HotStuff != nil && w.chainConfig.HotStuff.Instant
+}
+
 // close terminates all background threads maintained by the worker.
 // Note the worker does not support being closed multiple times.
 func (w *worker) close() {
@@ -402,10 +418,15 @@ func (w *worker) newWorkLoop(recommit time.Duration) {
 			timestamp = time.Now().Unix()
 			commit(false, commitInterruptNewHead)
 
+		case head := <-w.certifiedCh:
+			clearPending(head.NumberU64())
+			timestamp = time.Now().Unix()
+			commit(false, commitInterruptNewHead)
+
 		case <-timer.C:
 			// If mining is running resubmit a new work cycle periodically to pull in
 			// higher priced transactions. Disable this overhead for pending blocks.
-			if w.isRunning() && (w.chainConfig.Clique == nil || w.chainConfig.Clique.Period > 0) {
+			if w.isRunning() && !w.isInstant() {
 				// Short circuit if no new transaction arrives.
 				if atomic.LoadInt32(&w.newTxs) == 0 {
 					timer.Reset(recommit)
@@ -456,6 +477,9 @@ func (w *worker) mainLoop() {
 	defer w.txsSub.Unsubscribe()
 	defer w.chainHeadSub.Unsubscribe()
 	defer w.chainSideSub.Unsubscribe()
+	if w.certifiedSub != nil {
+		defer w.certifiedSub.Unsubscribe()
+	}
 	defer func() {
 		if w.current != nil && w.current.state != nil {
 			w.current.state.StopPrefetcher()
@@ -535,10 +559,11 @@ func (w *worker) mainLoop() {
 					w.updateSnapshot()
 				}
 			} else {
-				// Special case, if the consensus engine is 0 period clique(dev mode),
-				// submit mining work here since all empty submission will be rejected
-				// by clique. Of course the advance sealing(empty submission) is disabled.
-				if w.chainConfig.Clique != nil && w.chainConfig.Clique.Period == 0 {
+				// Special case, if the consensus engine is 0 period clique or instant
+				// hotstuff (dev mode), submit mining work here since all empty submission
+				// will be rejected by the engine. Of course the advance sealing(empty
+				// submission) is disabled.
+				if w.isInstant() {
 					w.commitNewWork(nil, true, time.Now().Unix())
 				}
 			}
@@ -912,6 +937,9 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 
 	tstart := time.Now()
 	parent := w.chain.CurrentBlock()
+	if certified, ok := w.engine.(consensus.CertifiedChain); ok {
+		parent = certified.CertifiedHead(parent)
+	}
 
 	if parent.Time() >= uint64(timestamp) {
 		timestamp = int64(parent.Time() + 1)
@@ -944,6 +972,12 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 		log.Error("Failed to prepare header for mining", "err", err)
 		return
 	}
+	// The engine may credit the fees to another account than the etherbase
+	// (e.g. hotstuff pays the validator sealing the block), follow the header.
+	coinbase := w.coinbase
+	if header.Coinbase != (common.Address{}) {
+		coinbase = header.Coinbase
+	}
 	// If we are care about TheDAO hard-fork check whether to override the extra-data or not
 	if daoBlock := w.chainConfig.DAOForkBlock; daoBlock != nil {
 		// Check whether the block is among the fork extra-override range
@@ -1018,13 +1052,13 @@ func (w *worker) commitNewWork(interrupt *int32, noempty bool, timestamp int64)
 	}
 	if len(localTxs) > 0 {
 		txs := types.NewTransactionsByPriceAndNonce(w.current.signer, localTxs, header.BaseFee)
-		if w.commitTransactions(txs, w.coinbase, interrupt) {
+		if w.commitTransactions(txs, coinbase, interrupt) {
 			return
 		}
 	}
 	if len(remoteTxs) > 0 {
 		txs := types.NewTransactionsByPriceAndNonce(w.current.signer, remoteTxs, header.BaseFee)
-		if w.commitTransactions(txs, w.coinbase, interrupt) {
+		if w.commitTransactions(txs, coinbase, interrupt) {
 			return
 		}
 	}
@@ -19,8 +19,6 @@ package node
 import (
 	"crypto/ecdsa"
 	"fmt"
-	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
-	common2 "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
 	"io/ioutil"
 	"os"
 	"path/filepath"
@@ -28,16 +26,20 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/log"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	common2 "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
 )
 
 const (
 	datadirPrivateKey      = "nodekey"            // Path within the datadir to the node's private key
+	datadirConsensusKey    = "consensuskey"       // Path within the datadir to the node's encrypted BLS consensus key
 	datadirDefaultKeyStore = "keystore"           // Path within the datadir to the keystore
 	datadirStaticNodes     = "static-nodes.json"  // Path within the datadir to the static node list
 	datadirTrustedNodes    = "trusted-nodes.json" // Path within the datadir to the trusted node list
@@ -70,6 +72,14 @@ type Config struct {
 	// Configuration of peer-to-peer networking.
 	P2P p2p.Config
 
+	// ConsensusPrivateKey is the BLS key signing the hotstuff consensus messages. If it
+	// is not set, the key is loaded from the data directory, or generated there.
+	ConsensusPrivateKey common2.SecretKey `toml:"-"`
+
+	// ConsensusKeyPassword decrypts the BLS consensus key stored in the data
+	// directory, and encrypts it when the key is generated.
+	ConsensusKeyPassword string `toml:"-"`
+
 	// KeyStoreDir is the file system folder that contains private keys. The directory can
 	// be specified as a relative path, in which case it is resolved relative to the
 	// current directory.
@@ -82,6 +92,11 @@ type Config struct {
 	// ExternalSigner specifies an external URI for a clef-type signer
 	ExternalSigner string `toml:",omitempty"`
 
+	// ConsensusSigner is the path to the ipc file of a clef instance holding the
+	// hotstuff validator keys. If set, the node key and consensus key of the node
+	// are not used to sign consensus messages.
+	ConsensusSigner string `toml:",omitempty"`
+
 	// UseLightweightKDF lowers the memory and CPU requirements of the key store
 	// scrypt KDF at the expense of security.
 	UseLightweightKDF bool `toml:",omitempty"`
@@ -387,11 +402,43 @@ func (c *Config) NodeKey() *ecdsa.PrivateKey {
 // first any manually set key, falling back to the one found in the configured
 // data folder. If no key can be found, a new one is generated.
 func (c *Config) ConsensusKey() *common2.SecretKey {
-	// Hotstuff ToDo:
-	// mange consensus key
-	// Generate 32 bytes of randomness
-	sk, _ := blst.RandKey()
-	return &sk
+	// Use any specifically configured key.
+	if c.ConsensusPrivateKey != nil {
+		return &c.ConsensusPrivateKey
+	}
+	// Generate ephemeral key if no datadir is being used.
+	if c.DataDir == "" {
+		key, err := blst.RandKey()
+		if err != nil {
+			log.Crit(fmt.Sprintf("Failed to generate ephemeral consensus key: %v", err))
+		}
+		return &key
+	}
+
+	// Unlike the node key, an existing consensus key which cannot be decrypted
+	// must not be replaced, since the validator is registered with it.
+	keyfile := c.ResolvePath(datadirConsensusKey)
+	if _, err := os.Stat(keyfile); err == nil {
+		key, err := keystore.LoadBLSKey(keyfile, c.ConsensusKeyPassword)
+		if err != nil {
+			log.Crit(fmt.Sprintf("Failed to load consensus key: %v", err))
+		}
+		return &key
+	}
+	// No persistent key found, generate and store a new one.
+	key, err := blst.RandKey()
+	if err != nil {
+		log.Crit(fmt.Sprintf("Failed to generate consensus key: %v", err))
+	}
+	scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+	if c.UseLightweightKDF {
+		scryptN, scryptP = keystore.LightScryptN, keystore.LightScryptP
+	}
+	keyfile = filepath.Join(c.instanceDir(), datadirConsensusKey)
+	if err := keystore.StoreBLSKey(keyfile, key, c.ConsensusKeyPassword, scryptN, scryptP); err != nil {
+		log.Error(fmt.Sprintf("Failed to persist consensus key: %v", err))
+	}
+	return &key
 }
 
 // StaticNodes returns a list of node enode URLs configured as static nodes.
@@ -18,10 +18,12 @@ package params
 
 import (
 	"encoding/binary"
+	"encoding/json"
 	"fmt"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"golang.org/x/crypto/sha3"
 )
 
@@ -378,12 +380,81 @@ func (c *CliqueConfig) String() string {
 
 // HotStuffConfig is the consensus engine configs for hotstuff based sealing.
 type HotStuffConfig struct {
-	Epoch       uint64 `json:"epoch"`       // Epoch length to reset votes and checkpoint
-	ElectPolicy uint64 `json:"electPolicy"` // proposer election policy
+	Period         uint64              `json:"period,omitempty"`         // Number of seconds between blocks to enforce
+	RequestTimeout uint64              `json:"requestTimeout,omitempty"` // Timeout of the first round of a height in milliseconds
+	Epoch          uint64              `json:"epoch"`                    // Epoch length to reset votes and checkpoint
+	ElectPolicy    uint64              `json:"policy"`                   // proposer election policy
+	Chained        bool                `json:"chained,omitempty"`        // Whether to run the chained hotstuff protocol, committing one block per phase
+	Instant        bool                `json:"instant,omitempty"`        // Whether to seal blocks as soon as transactions arrive, ignoring the period
+	Validators     []HotStuffValidator `json:"validators,omitempty"`     // Initial validators recorded in the genesis block
+	Staking        *HotStuffStaking    `json:"staking,omitempty"`        // Staking contract electing the validators at checkpoints, instead of votes
+
+	BlockReward   *big.Int        `json:"blockReward,omitempty"`   // Wei issued with every block, none if nil
+	ProposerShare uint64          `json:"proposerShare,omitempty"` // Percentage of the block reward paid to the proposer
+	Treasury      *common.Address `json:"treasury,omitempty"`      // Account paid the treasury share, if any
+	TreasuryShare uint64          `json:"treasuryShare,omitempty"` // Percentage of the block reward paid to the treasury
+}
+
+// HotStuffValidator is a validator of the genesis block of a hotstuff chain.
+type HotStuffValidator struct {
+	Address   common.Address `json:"address"`   // Account of the validator
+	PublicKey hexutil.Bytes  `json:"publicKey"` // BLS public key the validator signs consensus messages with
+}
+
+// HotStuffStaking is the staking contract electing the validators of a hotstuff
+// chain by their stake at every checkpoint.
+type HotStuffStaking struct {
+	Contract      common.Address `json:"contract"`                // Genesis account of the staking contract
+	MinStake      *big.Int       `json:"minStake,omitempty"`      // Stake needed to be elected, any if nil
+	MaxValidators uint64         `json:"maxValidators,omitempty"` // Number of candidates with the most stake elected, all if zero
+}
+
+// ValidatorAddresses returns the accounts of the initial validators.
+func (c *HotStuffConfig) ValidatorAddresses() []common.Address {
+	addrs := make([]common.Address, len(c.Validators))
+	for i, val := range c.Validators {
+		addrs[i] = val.Address
+	}
+	return addrs
+}
+
+// CheckRewards checks that the proposer and treasury shares of the block
+// reward leave a non negative share to the QC participants.
+func (c *HotStuffConfig) CheckRewards() error {
+	share := c.ProposerShare
+	if c.Treasury != nil {
+		share += c.TreasuryShare
+	}
+	if c.ProposerShare > 100 || c.TreasuryShare > 100 || share > 100 {
+		return fmt.Errorf("hotstuff reward shares exceed 100%%: proposer %d%%, treasury %d%%", c.ProposerShare, c.TreasuryShare)
+	}
+	return nil
+}
+
+// UnmarshalJSON parses a hotstuff config, accepting the proposer election policy
+// under its former electPolicy name too. The policy name wins if both are set.
+func (c *HotStuffConfig) UnmarshalJSON(input []byte) error {
+	type hotStuffConfig HotStuffConfig
+	var dec struct {
+		hotStuffConfig
+		Policy      *uint64 `json:"policy"`
+		ElectPolicy *uint64 `json:"electPolicy"`
+	}
+	if err := json.Unmarshal(input, &dec); err != nil {
+		return err
+	}
+	*c = HotStuffConfig(dec.hotStuffConfig)
+	switch {
+	case dec.Policy != nil:
+		c.ElectPolicy = *dec.Policy
+	case dec.ElectPolicy != nil:
+		c.ElectPolicy = *dec.ElectPolicy
+	}
+	return nil
 }
 
 func (c *HotStuffConfig) String() string {
-	return "hotstuff"
+	return fmt.Sprintf("hotstuff{period: %v, instant: %v, epoch: %v, policy: %v, chained: %v, validators: %v, staking: %v, reward: %v}", c.Period, c.Instant, c.Epoch, c.ElectPolicy, c.Chained, len(c.Validators), c.Staking != nil, c.BlockReward)
 }
 
 // String implements the fmt.Stringer interface.
@@ -394,6 +465,8 @@ func (c *ChainConfig) String() string {
 		engine = c.Ethash
 	case c.Clique != nil:
 		engine = c.Clique
+	case c.HotStuff != nil:
+		engine = c.HotStuff
 	default:
 		engine = "unknown"
 	}
@@ -17,6 +17,7 @@
 package params
 
 import (
+	"encoding/json"
 	"math/big"
 	"reflect"
 	"testing"
@@ -96,3 +97,24 @@ func TestCheckCompatible(t *testing.T) {
 		}
 	}
 }
+
+func TestHotStuffConfigUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		input  string
+		policy uint64
+	}{
+		{`{"epoch": 30000, "policy": 1}`, 1},
+		{`{"epoch": 30000, "electPolicy": 1}`, 1},
+		{`{"epoch": 30000, "policy": 1, "electPolicy": 2}`, 1},
+		{`{"epoch": 30000}`, 0},
+	}
+	for i, tt := range tests {
+		var config HotStuffConfig
+		if err := json.Unmarshal([]byte(tt.input), &config); err != nil {
+			t.Fatalf("test %d: failed to unmarshal config: %v", i, err)
+		}
+		if config.Epoch != 30000 || config.ElectPolicy != tt.policy {
+			t.Errorf("test %d: config mismatch: have epoch %d policy %d, want epoch 30000 policy %d", i, config.Epoch, config.ElectPolicy, tt.policy)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	hotstuff "github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+)
+
+var (
+	// errInvalidConsensusMessage is returned if a consensus message to sign can't
+	// be decoded.
+	errInvalidConsensusMessage = errors.New("invalid consensus message")
+
+	// errInvalidConsensusSender is returned if a consensus message or a header to
+	// sign is not from the validator clef signs for.
+	errInvalidConsensusSender = errors.New("consensus message of another validator")
+
+	// errInvalidVoteCode is returned if a vote is requested for a phase which is
+	// not voted.
+	errInvalidVoteCode = errors.New("invalid vote code")
+)
+
+// ConsensusAPI signs the consensus messages of a hotstuff validator, whose leader
+// key is an unlocked account of the keystore and consensus key a BLS key, so that
+// the node running the validator does not hold them. Every message which could
+// be used as evidence of an equivocation is checked against the slashing
+// protection database first, and refused if it would equivocate.
+type ConsensusAPI struct {
+	account    accounts.Account
+	keystore   *keystore.KeyStore
+	key        blscommon.SecretKey
+	protection *SlashingProtection
+}
+
+// NewConsensusAPI creates the consensus signing API of the validator. The
+// account must be unlocked in the keystore.
+func NewConsensusAPI(ks *keystore.KeyStore, account accounts.Account, key blscommon.SecretKey, protection *SlashingProtection) *ConsensusAPI {
+	return &ConsensusAPI{
+		account:    account,
+		keystore:   ks,
+		key:        key,
+		protection: protection,
+	}
+}
+
+// Address returns the account of the leader key.
+func (api *ConsensusAPI) Address() common.Address {
+	return api.account.Address
+}
+
+// PublicKey returns the BLS public key of the consensus key.
+func (api *ConsensusAPI) PublicKey() hexutil.Bytes {
+	return api.key.PublicKey().Marshal()
+}
+
+// SignSeal signs the leader seal of a header proposed by the validator. A seal
+// alone proves no equivocation, the PREPARE message proposing the block does, so
+// the seal is only refused if the validator already signed past its height.
+func (api *ConsensusAPI) SignSeal(header *types.Header) (hexutil.Bytes, error) {
+	if header.Number == nil || header.Coinbase != api.account.Address {
+		return nil, errInvalidConsensusSender
+	}
+	if err := api.protection.CheckHeight(api.account.Address, header.Number.Uint64()); err != nil {
+		log.Warn("Refused to seal header", "number", header.Number, "err", err)
+		return nil, err
+	}
+	return api.sign(hotstuff.SigHash(header).Bytes())
+}
+
+// SignMessage signs the rlp encoding of a consensus message without signature.
+// PREPARE messages and votes are checked against the slashing protection.
+func (api *ConsensusAPI) SignMessage(payload hexutil.Bytes) (hexutil.Bytes, error) {
+	msg := new(hotstuff.Message)
+	if err := rlp.DecodeBytes(payload, msg); err != nil {
+		return nil, errInvalidConsensusMessage
+	}
+	if msg.Address != api.account.Address {
+		return nil, errInvalidConsensusSender
+	}
+	digest, slashable, err := hotstuff.EquivocationDigest(msg)
+	if err != nil {
+		return nil, errInvalidConsensusMessage
+	}
+	if slashable {
+		if msg.View == nil || msg.View.Height == nil || msg.View.Round == nil {
+			return nil, errInvalidConsensusMessage
+		}
+		if err := api.check(uint64(msg.Code), msg.View, digest); err != nil {
+			return nil, err
+		}
+	}
+	return api.sign(payload)
+}
+
+// SignVote signs the vote of the given phase of the view with the consensus key,
+// after checking it against the slashing protection.
+func (api *ConsensusAPI) SignVote(code, height, round hexutil.Uint64, digest common.Hash) (hexutil.Bytes, error) {
+	switch hotstuff.MsgType(code) {
+	case hotstuff.MsgTypePrepareVote, hotstuff.MsgTypePreCommitVote, hotstuff.MsgTypeCommitVote, hotstuff.MsgTypeGenericVote:
+	default:
+		return nil, errInvalidVoteCode
+	}
+	view := &hotstuff.View{
+		Height: new(big.Int).SetUint64(uint64(height)),
+		Round:  new(big.Int).SetUint64(uint64(round)),
+	}
+	if err := api.check(uint64(code), view, digest); err != nil {
+		return nil, err
+	}
+	msg := hotstuff.VoteDigest(hotstuff.MsgType(code), view, digest)
+	return api.key.Sign(msg[:]).Marshal(), nil
+}
+
+// SignVRF signs the proof of the election of the proposer in the given round
+// with the consensus key.
+func (api *ConsensusAPI) SignVRF(parent common.Hash, round hexutil.Uint64) (hexutil.Bytes, error) {
+	msg := hotstuff.VRFMessage(parent, uint64(round))
+	return api.key.Sign(msg[:]).Marshal(), nil
+}
+
+// check records the phase of the view the validator signs for the digest in the
+// slashing protection, unless it would equivocate.
+func (api *ConsensusAPI) check(code uint64, view *hotstuff.View, digest common.Hash) error {
+	signed := &SignedView{
+		Height: view.Height.Uint64(),
+		Round:  view.Round.Uint64(),
+		Phase:  code,
+		Digest: digest,
+	}
+	if err := api.protection.Check(api.account.Address, signed); err != nil {
+		log.Warn("Refused to sign consensus message", "code", hotstuff.MsgType(code), "view", view, "digest", digest, "err", err)
+		return err
+	}
+	return nil
+}
+
+// sign signs the keccak256 hash of the data with the leader key.
+func (api *ConsensusAPI) sign(data []byte) (hexutil.Bytes, error) {
+	return api.keystore.SignHash(api.account, crypto.Keccak256(data))
+}
@@ -0,0 +1,223 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"bytes"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	hotstuff "github.com/ethereum/go-ethereum/consensus/hotstuff/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/prysmaticlabs/prysm/v3/crypto/bls/blst"
+	blscommon "github.com/prysmaticlabs/prysm/v3/crypto/bls/common"
+)
+
+// newTestConsensusAPI creates the consensus API of a fresh validator, keeping
+// its slashing protection database at path.
+func newTestConsensusAPI(t *testing.T, path string) (*ConsensusAPI, blscommon.SecretKey) {
+	ks := keystore.NewKeyStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+	key, _ := crypto.GenerateKey()
+	account, err := ks.ImportECDSA(key, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ks.Unlock(account, ""); err != nil {
+		t.Fatal(err)
+	}
+	blsKey, err := blst.RandKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	protection, err := NewSlashingProtection(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return NewConsensusAPI(ks, account, blsKey, protection), blsKey
+}
+
+// testMessage returns the payload to sign of a consensus message.
+func testMessage(t *testing.T, addr common.Address, code hotstuff.MsgType, height, round int64, val interface{}) hexutil.Bytes {
+	inner, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &hotstuff.Message{
+		Code:    code,
+		View:    &hotstuff.View{Height: big.NewInt(height), Round: big.NewInt(round)},
+		Msg:     inner,
+		Address: addr,
+	}
+	payload, err := msg.PayloadNoSig()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return payload
+}
+
+// testVote returns the payload to sign of a vote message.
+func testVote(t *testing.T, addr common.Address, code hotstuff.MsgType, height, round int64, digest common.Hash) hexutil.Bytes {
+	view := &hotstuff.View{Height: big.NewInt(height), Round: big.NewInt(round)}
+	return testMessage(t, addr, code, height, round, &hotstuff.Vote{Code: code, View: view, Digest: digest})
+}
+
+func TestConsensusSignVote(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "slashing.json")
+	api, blsKey := newTestConsensusAPI(t, path)
+	addr := api.Address()
+
+	var (
+		prepareVote = hexutil.Uint64(hotstuff.MsgTypePrepareVote)
+		commitVote  = hexutil.Uint64(hotstuff.MsgTypeCommitVote)
+		digest      = common.Hash{0x01}
+		other       = common.Hash{0x02}
+	)
+	sig, err := api.SignVote(prepareVote, 10, 1, digest)
+	if err != nil {
+		t.Fatalf("failed to sign vote: %v", err)
+	}
+	view := &hotstuff.View{Height: big.NewInt(10), Round: big.NewInt(1)}
+	msg := hotstuff.VoteDigest(hotstuff.MsgTypePrepareVote, view, digest)
+	if seal, err := blst.SignatureFromBytes(sig); err != nil || !seal.Verify(blsKey.PublicKey(), msg[:]) {
+		t.Fatalf("invalid vote signature: %v", err)
+	}
+	// The envelope of the vote, and the vote itself again, can be signed
+	payload := testVote(t, addr, hotstuff.MsgTypePrepareVote, 10, 1, digest)
+	if sig, err = api.SignMessage(payload); err != nil {
+		t.Fatalf("failed to sign vote message: %v", err)
+	}
+	if pub, err := crypto.SigToPub(crypto.Keccak256(payload), sig); err != nil || crypto.PubkeyToAddress(*pub) != addr {
+		t.Fatalf("invalid message signature: %v", err)
+	}
+	if _, err := api.SignVote(prepareVote, 10, 1, digest); err != nil {
+		t.Fatalf("failed to sign vote again: %v", err)
+	}
+	// Conflicting and past votes are refused
+	if _, err := api.SignVote(prepareVote, 10, 1, other); err != ErrSlashableDigest {
+		t.Fatalf("conflicting vote error mismatch: have %v, want %v", err, ErrSlashableDigest)
+	}
+	if _, err := api.SignMessage(testVote(t, addr, hotstuff.MsgTypePrepareVote, 10, 1, other)); err != ErrSlashableDigest {
+		t.Fatalf("conflicting vote message error mismatch: have %v, want %v", err, ErrSlashableDigest)
+	}
+	if _, err := api.SignVote(commitVote, 10, 2, digest); err != nil {
+		t.Fatalf("failed to sign next round: %v", err)
+	}
+	if _, err := api.SignVote(prepareVote, 10, 2, digest); err != ErrSlashableView {
+		t.Fatalf("past phase error mismatch: have %v, want %v", err, ErrSlashableView)
+	}
+	if _, err := api.SignVote(commitVote, 9, 5, digest); err != ErrSlashableView {
+		t.Fatalf("past height error mismatch: have %v, want %v", err, ErrSlashableView)
+	}
+	if _, err := api.SignVote(hexutil.Uint64(hotstuff.MsgTypePrepare), 11, 0, digest); err != errInvalidVoteCode {
+		t.Fatalf("vote code error mismatch: have %v, want %v", err, errInvalidVoteCode)
+	}
+	// Messages which can't equivocate are signed in any view, but only for the validator
+	if _, err := api.SignMessage(testMessage(t, addr, hotstuff.MsgTypeNewView, 9, 0, &hotstuff.MsgNewView{})); err != nil {
+		t.Fatalf("failed to sign past NEW_VIEW: %v", err)
+	}
+	if _, err := api.SignMessage(testVote(t, common.Address{0xff}, hotstuff.MsgTypePrepareVote, 11, 0, digest)); err != errInvalidConsensusSender {
+		t.Fatalf("sender error mismatch: have %v, want %v", err, errInvalidConsensusSender)
+	}
+	// The signed views survive a restart
+	protection, err := NewSlashingProtection(path)
+	if err != nil {
+		t.Fatalf("failed to reopen slashing protection: %v", err)
+	}
+	api.protection = protection
+	if _, err := api.SignVote(prepareVote, 10, 2, digest); err != ErrSlashableView {
+		t.Fatalf("past phase error mismatch after restart: have %v, want %v", err, ErrSlashableView)
+	}
+	if signed := protection.Signed(addr); signed == nil || signed.Height != 10 || signed.Round != 2 || signed.Phase != uint64(commitVote) {
+		t.Fatalf("highest signed view mismatch: have %+v", signed)
+	}
+}
+
+func TestConsensusSignSeal(t *testing.T) {
+	api, _ := newTestConsensusAPI(t, filepath.Join(t.TempDir(), "slashing.json"))
+	addr := api.Address()
+
+	header := &types.Header{Number: big.NewInt(10), Coinbase: addr, Difficulty: big.NewInt(1)}
+	if err := types.HotstuffHeaderFillWithValidators(header, []common.Address{addr}); err != nil {
+		t.Fatal(err)
+	}
+	sig, err := api.SignSeal(header)
+	if err != nil {
+		t.Fatalf("failed to seal header: %v", err)
+	}
+	if pub, err := crypto.SigToPub(crypto.Keccak256(hotstuff.SigHash(header).Bytes()), sig); err != nil || crypto.PubkeyToAddress(*pub) != addr {
+		t.Fatalf("invalid seal: %v", err)
+	}
+	if _, err := api.SignVote(hexutil.Uint64(hotstuff.MsgTypeCommitVote), 11, 0, common.Hash{}); err != nil {
+		t.Fatalf("failed to sign vote: %v", err)
+	}
+	if _, err := api.SignSeal(header); err != ErrSlashableView {
+		t.Fatalf("past seal error mismatch: have %v, want %v", err, ErrSlashableView)
+	}
+	header.Number, header.Coinbase = big.NewInt(12), common.Address{0xff}
+	if _, err := api.SignSeal(header); err != errInvalidConsensusSender {
+		t.Fatalf("coinbase error mismatch: have %v, want %v", err, errInvalidConsensusSender)
+	}
+}
+
+// Tests that geth's remote signer reaches the consensus API over IPC.
+func TestConsensusRemoteSigner(t *testing.T) {
+	api, blsKey := newTestConsensusAPI(t, filepath.Join(t.TempDir(), "slashing.json"))
+
+	endpoint := filepath.Join(t.TempDir(), "clef.ipc")
+	listener, server, err := rpc.StartIPCEndpoint(endpoint, []rpc.API{{
+		Namespace: "consensus",
+		Service:   api,
+	}})
+	if err != nil {
+		t.Fatalf("failed to start ipc endpoint: %v", err)
+	}
+	defer server.Stop()
+	defer listener.Close()
+
+	signer, err := external.NewConsensusSigner(endpoint)
+	if err != nil {
+		t.Fatalf("failed to connect to signer: %v", err)
+	}
+	defer signer.Close()
+
+	if signer.Address() != api.Address() {
+		t.Fatalf("address mismatch: have %v, want %v", signer.Address(), api.Address())
+	}
+	if have, want := signer.PublicKey(), blsKey.PublicKey().Marshal(); !bytes.Equal(have, want) {
+		t.Fatalf("public key mismatch: have %x, want %x", have, want)
+	}
+	view := &hotstuff.View{Height: big.NewInt(3), Round: big.NewInt(0)}
+	sig, err := signer.SignVote(hotstuff.MsgTypePrepareVote, view, common.Hash{0x01})
+	if err != nil {
+		t.Fatalf("failed to sign vote: %v", err)
+	}
+	msg := hotstuff.VoteDigest(hotstuff.MsgTypePrepareVote, view, common.Hash{0x01})
+	if seal, err := blst.SignatureFromBytes(sig); err != nil || !seal.Verify(blsKey.PublicKey(), msg[:]) {
+		t.Fatalf("invalid vote signature: %v", err)
+	}
+	if _, err := signer.SignVote(hotstuff.MsgTypePrepareVote, view, common.Hash{0x02}); err == nil {
+		t.Fatal("conflicting vote signed")
+	}
+}
@@ -0,0 +1,191 @@
+// Copyright 2022 The Unicorn Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	// ErrSlashableView is returned if a consensus signature is requested for a
+	// view and phase below the highest one already signed.
+	ErrSlashableView = errors.New("view already signed past")
+
+	// ErrSlashableDigest is returned if a consensus signature is requested for the
+	// highest view and phase signed, but another proposal.
+	ErrSlashableDigest = errors.New("view already signed for another proposal")
+)
+
+// SignedView is the highest view and phase a validator signed a consensus message
+// for, along the proposal it committed to.
+type SignedView struct {
+	Height uint64      `json:"height"`
+	Round  uint64      `json:"round"`
+	Phase  uint64      `json:"phase"`
+	Digest common.Hash `json:"digest"`
+}
+
+// cmp compares the view and phase of v and w, returning -1 if v is lower, 1 if
+// it is higher and 0 if they are the same.
+func (v *SignedView) cmp(w *SignedView) int {
+	for _, c := range [][2]uint64{{v.Height, w.Height}, {v.Round, w.Round}, {v.Phase, w.Phase}} {
+		if c[0] < c[1] {
+			return -1
+		}
+		if c[0] > c[1] {
+			return 1
+		}
+	}
+	return 0
+}
+
+// SlashingProtection is the local database of the highest view and phase every
+// validator signed, which refuses the signatures that would equivocate. The
+// database is written to disk before any signature it allows is released.
+type SlashingProtection struct {
+	path   string
+	signed map[common.Address]*SignedView
+	lock   sync.Mutex
+}
+
+// NewSlashingProtection opens the slashing protection database at the given
+// path, creating it on the first signature.
+func NewSlashingProtection(path string) (*SlashingProtection, error) {
+	p := &SlashingProtection{
+		path:   path,
+		signed: make(map[common.Address]*SignedView),
+	}
+	blob, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return p, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(blob, &p.signed); err != nil {
+		return nil, fmt.Errorf("corrupted slashing protection database %s: %v", path, err)
+	}
+	return p, nil
+}
+
+// Signed returns the highest view and phase the validator signed, if any.
+func (p *SlashingProtection) Signed(addr common.Address) *SignedView {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if view := p.signed[addr]; view != nil {
+		cpy := *view
+		return &cpy
+	}
+	return nil
+}
+
+// Check records the view and phase the validator is about to sign, unless it
+// is below the highest one already signed, or the same one for another digest.
+// Signing again the same message is allowed.
+func (p *SlashingProtection) Check(addr common.Address, view *SignedView) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if last := p.signed[addr]; last != nil {
+		switch cmp := view.cmp(last); {
+		case cmp < 0:
+			return ErrSlashableView
+		case cmp == 0 && view.Digest != last.Digest:
+			return ErrSlashableDigest
+		case cmp == 0:
+			return nil
+		}
+	}
+	last := p.signed[addr]
+	cpy := *view
+	p.signed[addr] = &cpy
+	if err := p.store(); err != nil {
+		p.signed[addr] = last
+		return err
+	}
+	return nil
+}
+
+// CheckHeight reports whether the validator did not sign past the given height.
+func (p *SlashingProtection) CheckHeight(addr common.Address, height uint64) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if last := p.signed[addr]; last != nil && height < last.Height {
+		return ErrSlashableView
+	}
+	return nil
+}
+
+// store writes the database to disk, replacing the previous one at once. The
+// rename is synced too, so a crash never brings back a lower view.
+func (p *SlashingProtection) store() error {
+	blob, err := json.Marshal(p.signed)
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(p.path)
+	f, err := os.CreateTemp(dir, filepath.Base(p.path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(blob); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	if err := os.Rename(f.Name(), p.path); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return syncDir(dir)
+}
+
+// syncDir flushes the entries of the directory to disk. Windows doesn't allow
+// syncing directories, and commits renames on its own.
+func syncDir(dir string) error {
+	if runtime.GOOS == "windows" {
+		return nil
+	}
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err := d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}